@@ -85,8 +85,13 @@ func main() {
 	}
 	zk.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials())) // 默认RPC中间件
 	engine := gin.Default()
-	engine.Use(mw.CorsHandler(), mw.GinParseOperationID(), mw2.GinLog())
-	api.NewAdminRoute(engine, zk)
+	engine.Use(mw.CorsHandler(), api.SCIMOperationID, mw.GinParseOperationID(), mw2.GinLog())
+	ldapSync, err := api.NewLDAPSync(zk)
+	if err != nil {
+		panic(err)
+	}
+	api.NewAdminRoute(engine, zk, ldapSync)
+	go ldapSync.Loop()
 
 	address := net.JoinHostPort(config.Config.AdminApi.ListenIP, strconv.Itoa(ginPort))
 	if err := engine.Run(address); err != nil {
@@ -86,7 +86,7 @@ func main() {
 	}
 	zk.AddOption(mw.GrpcClient(), grpc.WithTransportCredentials(insecure.NewCredentials())) // 默认RPC中间件
 	engine := gin.Default()
-	engine.Use(mw.CorsHandler(), mw.GinParseOperationID(), mw2.GinLog())
+	engine.Use(mw.CorsHandler(), mw.GinParseOperationID(), mw2.GinLog(), api.Language)
 	api.NewChatRoute(engine, zk)
 	address := net.JoinHostPort(config.Config.ChatApi.ListenIP, strconv.Itoa(ginPort))
 	if err := engine.Run(address); err != nil {
@@ -17,6 +17,7 @@ package api
 import (
 	"context"
 	"crypto/md5"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"github.com/OpenIMSDK/chat/pkg/common/apicall"
@@ -79,11 +80,24 @@ func (o *AdminApi) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, err) // 参数校验失败
 		return
 	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
 	loginResp, err := o.adminClient.Login(c, &req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	if loginResp.TwoFactorChallenge != "" {
+		resp.AdminAccount = loginResp.AdminAccount
+		resp.TwoFactorChallenge = loginResp.TwoFactorChallenge
+		resp.TwoFactorSetup = loginResp.TwoFactorSetup
+		apiresp.GinSuccess(c, resp)
+		return
+	}
 	imAdminUserID := config.GetIMAdmin(loginResp.AdminUserID)
 	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
 	if err != nil {
@@ -101,10 +115,176 @@ func (o *AdminApi) AdminLogin(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *AdminApi) AdminTwoFactorLogin(c *gin.Context) {
+	var (
+		req  admin.TwoFactorLoginReq
+		resp apistruct.AdminLoginResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	loginResp, err := o.adminClient.TwoFactorLogin(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imAdminUserID := config.GetIMAdmin(loginResp.AdminUserID)
+	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	err = utils.CopyStructFields(&resp, loginResp)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp.ImToken = imToken
+	resp.ImUserID = imAdminUserID
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *AdminApi) RefreshToken(c *gin.Context) {
+	var req admin.RefreshTokenReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UserType = constant2.AdminUser
+	resp, err := o.adminClient.RefreshToken(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *AdminApi) Logout(c *gin.Context) {
+	o.revokeSessions(c, &admin.RevokeSessionsReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token"), Current: true})
+}
+
+func (o *AdminApi) GetUserSessions(c *gin.Context) {
+	a2r.Call(admin.AdminClient.GetSessions, o.adminClient, c)
+}
+
+func (o *AdminApi) RevokeUserSessions(c *gin.Context) {
+	var req admin.RevokeSessionsReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Token = ""
+	req.Current = false
+	o.revokeSessions(c, &req)
+}
+
+func (o *AdminApi) revokeSessions(c *gin.Context, req *admin.RevokeSessionsReq) {
+	resp, err := o.adminClient.RevokeSessions(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if len(resp.Platforms) > 0 {
+		imToken, err := o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		if err := o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), req.UserID, resp.Platforms...); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *AdminApi) SetupTwoFactor(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetupTwoFactor, o.adminClient, c)
+}
+
+func (o *AdminApi) EnableTwoFactor(c *gin.Context) {
+	a2r.Call(admin.AdminClient.EnableTwoFactor, o.adminClient, c)
+}
+
+func (o *AdminApi) DisableTwoFactor(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DisableTwoFactor, o.adminClient, c)
+}
+
+func (o *AdminApi) GenTwoFactorRecoveryCodes(c *gin.Context) {
+	a2r.Call(admin.AdminClient.GenTwoFactorRecoveryCodes, o.adminClient, c)
+}
+
+func (o *AdminApi) ResetAdminTwoFactor(c *gin.Context) {
+	a2r.Call(admin.AdminClient.ResetAdminTwoFactor, o.adminClient, c)
+}
+
+func (o *AdminApi) UnlockAdminLogin(c *gin.Context) {
+	a2r.Call(admin.AdminClient.UnlockAdminLogin, o.adminClient, c)
+}
+
+func (o *AdminApi) SearchAdminLoginIP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAdminLoginIP, o.adminClient, c)
+}
+
+func (o *AdminApi) AddAdminLoginIP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddAdminLoginIP, o.adminClient, c)
+}
+
+func (o *AdminApi) DelAdminLoginIP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelAdminLoginIP, o.adminClient, c)
+}
+
 func (o *AdminApi) ResetUserPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ChangePassword, o.chatClient, c)
 }
 
+func (o *AdminApi) SetMustChangePassword(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SetMustChangePassword, o.chatClient, c)
+}
+
+func (o *AdminApi) ResetUserTwoFactor(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ResetUserTwoFactor, o.chatClient, c)
+}
+
+func (o *AdminApi) SearchUserLoginRecord(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SearchUserLoginRecord, o.chatClient, c)
+}
+
+func (o *AdminApi) StartUserDataExport(c *gin.Context) {
+	a2r.Call(chat.ChatClient.StartDataExport, o.chatClient, c)
+}
+
+func (o *AdminApi) GetUserDataExport(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetDataExport, o.chatClient, c)
+}
+
+func (o *AdminApi) GetMailTemplates(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetMailTemplates, o.chatClient, c)
+}
+
+func (o *AdminApi) SetMailTemplate(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SetMailTemplate, o.chatClient, c)
+}
+
+func (o *AdminApi) DelMailTemplate(c *gin.Context) {
+	a2r.Call(chat.ChatClient.DelMailTemplate, o.chatClient, c)
+}
+
+func (o *AdminApi) UnlockUserLogin(c *gin.Context) {
+	a2r.Call(chat.ChatClient.UnlockLogin, o.chatClient, c)
+}
+
 func (o *AdminApi) AdminUpdateInfo(c *gin.Context) {
 	var req admin.AdminUpdateInfoReq
 	if err := c.BindJSON(&req); err != nil {
@@ -155,7 +335,10 @@ func (o *AdminApi) AddUserAccount(c *gin.Context) {
 		return
 	}
 
-	_, err := o.chatClient.AddUserAccount(c, &req)
+	if _, err := o.chatClient.AddUserAccount(c, &req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 
 	userInfo := &sdkws.UserInfo{
 		UserID:     req.User.UserID,
@@ -163,8 +346,7 @@ func (o *AdminApi) AddUserAccount(c *gin.Context) {
 		FaceURL:    req.User.FaceURL,
 		CreateTime: time.Now().UnixMilli(),
 	}
-	err = o.imApiCaller.RegisterUser(c, []*sdkws.UserInfo{userInfo})
-	if err != nil {
+	if err := o.imApiCaller.RegisterUser(c, []*sdkws.UserInfo{userInfo}); err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
@@ -456,7 +638,7 @@ func (o *AdminApi) checkSecretAdmin(c *gin.Context, secret string) error {
 	if config.Config.ChatSecret == "" {
 		return errs.ErrNoPermission.Wrap("not config chat secret")
 	}
-	if config.Config.ChatSecret != secret {
+	if subtle.ConstantTimeCompare([]byte(config.Config.ChatSecret), []byte(secret)) != 1 {
 		return errs.ErrNoPermission.Wrap("secret error")
 	}
 	SetToken(c, config.GetDefaultIMAdmin(), constant2.AdminUser)
@@ -562,7 +744,7 @@ func (o *AdminApi) xlsx2user(users []model.User) ([]*chat.RegisterUserInfo, erro
 			PhoneNumber: info.PhoneNumber,
 			Email:       info.Email,
 			Account:     info.Account,
-			Password:    utils.Md5(info.Password),
+			Password:    utils.Md5(info.Password), // same as the client sends, chat-rpc stores its hash
 		}
 	}
 	return chatUsers, nil
@@ -636,3 +818,7 @@ func (o *AdminApi) BatchImportTemplate(c *gin.Context) {
 	c.Header("ETag", md5Val)
 	c.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", config.ImportTemplate)
 }
+
+func (o *AdminApi) GetJWKS(c *gin.Context) {
+	writeJWKS(c, o.adminClient)
+}
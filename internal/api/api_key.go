@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/OpenIMSDK/tools/a2r"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const ApiKeyHeader = "X-Api-Key"
+
+// apiKeyScopeKey marks requests whose api key has been checked for the scope of the route.
+const apiKeyScopeKey = "api_key_scope"
+
+// apiKeyScopes is the scope an api key needs for each admin route, routes not listed only accept admin tokens.
+var apiKeyScopes = map[string]string{
+	"/user/import/json":    constant.ScopeUserImport,
+	"/user/import/xlsx":    constant.ScopeUserImport,
+	"/organization/import": constant.ScopeUserImport,
+
+	"/user/add":              constant.ScopeUserWrite,
+	"/organization/user/add": constant.ScopeUserWrite,
+
+	"/organization/get":                      constant.ScopeOrgRead,
+	"/organization/department/find":          constant.ScopeOrgRead,
+	"/organization/department/all":           constant.ScopeOrgRead,
+	"/organization/department/expand":        constant.ScopeOrgRead,
+	"/organization/department/user":          constant.ScopeOrgRead,
+	"/organization/department/add":           constant.ScopeOrgWrite,
+	"/organization/department/update":        constant.ScopeOrgWrite,
+	"/organization/department/del":           constant.ScopeOrgWrite,
+	"/organization/department/sort":          constant.ScopeOrgWrite,
+	"/organization/department/member/add":    constant.ScopeOrgWrite,
+	"/organization/department/member/update": constant.ScopeOrgWrite,
+	"/organization/department/member/move":   constant.ScopeOrgWrite,
+	"/organization/department/member/del":    constant.ScopeOrgWrite,
+	"/organization/department/member/sort":   constant.ScopeOrgWrite,
+
+	"/statistic/new_user_count":   constant.ScopeStatsRead,
+	"/statistic/login_user_count": constant.ScopeStatsRead,
+}
+
+// checkApiKey authenticates the api key of the request for the scope of the route, the key acts as an admin named by its key id.
+func (o *MW) checkApiKey(c *gin.Context, apiKey string) error {
+	scope, ok := apiKeyScopes[c.FullPath()]
+	if !ok {
+		return errs.ErrNoPermission.Wrap("route not allowed for api keys")
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		return err
+	}
+	resp, err := o.client.CheckApiKey(c, &admin.CheckApiKeyReq{ApiKey: apiKey, Ip: ip, Scope: scope})
+	if err != nil {
+		return err
+	}
+	o.setToken(c, resp.KeyID, constant.AdminUser)
+	c.Set(apiKeyScopeKey, scope)
+	return nil
+}
+
+func (o *AdminApi) AddApiKey(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddApiKey, o.adminClient, c)
+}
+
+func (o *AdminApi) SearchApiKey(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchApiKey, o.adminClient, c)
+}
+
+func (o *AdminApi) DelApiKey(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelApiKey, o.adminClient, c)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/a2r"
+	"github.com/OpenIMSDK/tools/apiresp"
+	"github.com/OpenIMSDK/tools/checker"
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const (
+	auditExportPageSize = 1000
+	auditExportMaxRows  = 100000
+)
+
+func (o *AdminApi) SearchAuditLog(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAuditLog, o.adminClient, c)
+}
+
+func (o *AdminApi) VerifyAuditLog(c *gin.Context) {
+	a2r.Call(admin.AdminClient.VerifyAuditLog, o.adminClient, c)
+}
+
+// ExportAuditLog writes the entries matching the search as csv, newest first, the pagination of the request is ignored.
+func (o *AdminApi) ExportAuditLog(c *gin.Context) {
+	var req admin.SearchAuditLogReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// entries appended during the export would shift the pages
+	if req.EndTime == 0 {
+		req.EndTime = time.Now().UnixMilli()
+	}
+	req.Pagination = &sdkws.RequestPagination{PageNumber: 1, ShowNumber: auditExportPageSize}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"seq", "time", "operator", "action", "target", "detail", "ip", "error", "prev_hash", "hash"})
+	for rows := 0; rows < auditExportMaxRows; req.Pagination.PageNumber++ {
+		resp, err := o.adminClient.SearchAuditLog(c, &req)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		for _, e := range resp.Logs {
+			_ = w.Write([]string{
+				strconv.FormatInt(e.Seq, 10),
+				time.UnixMilli(e.CreateTime).Format(time.RFC3339Nano),
+				csvCell(e.Operator),
+				csvCell(e.Action),
+				csvCell(e.Target),
+				csvCell(e.Detail),
+				csvCell(e.Ip),
+				csvCell(e.Error),
+				e.PrevHash,
+				e.Hash,
+			})
+		}
+		rows += len(resp.Logs)
+		if len(resp.Logs) < auditExportPageSize {
+			break
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename=audit_log.csv")
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// csvCell keeps a spreadsheet from running a value as a formula by prefixing it with '.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -17,13 +17,19 @@ package api
 import (
 	"fmt"
 	"io"
+	"mime"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/apicall"
 	"github.com/OpenIMSDK/chat/pkg/common/apistruct"
 	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/checker"
 	"github.com/OpenIMSDK/tools/log"
@@ -36,6 +42,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/dataexport"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 )
@@ -77,6 +84,11 @@ func (o *ChatApi) VerifyCode(c *gin.Context) {
 	a2r.Call(chat.ChatClient.VerifyCode, o.chatClient, c)
 }
 
+// GetCaptcha issues the challenge answered when sending a verify code, the images are base64 encoded png.
+func (o *ChatApi) GetCaptcha(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetCaptcha, o.chatClient, c)
+}
+
 func (o *ChatApi) RegisterUser(c *gin.Context) {
 	var (
 		req  chat.RegisterUserReq
@@ -126,7 +138,8 @@ func (o *ChatApi) RegisterUser(c *gin.Context) {
 	if resp, err := o.adminClient.FindDefaultGroup(rpcCtx, &admin.FindDefaultGroupReq{}); err == nil {
 		_ = o.imApiCaller.InviteToGroup(apiCtx, respRegisterUser.UserID, resp.GroupIDs)
 	}
-	if req.AutoLogin {
+	// the im token is only issued with a full chat token, like loginResp does.
+	if respRegisterUser.ChatToken != "" && !respRegisterUser.PasswordChange {
 		resp.ImToken, err = o.imApiCaller.UserToken(c, respRegisterUser.UserID, req.Platform)
 		if err != nil {
 			apiresp.GinError(c, err)
@@ -134,16 +147,18 @@ func (o *ChatApi) RegisterUser(c *gin.Context) {
 		}
 	}
 	resp.ChatToken = respRegisterUser.ChatToken
+	resp.RefreshToken = respRegisterUser.RefreshToken
 	resp.UserID = respRegisterUser.UserID
+	resp.TwoFactorChallenge = respRegisterUser.TwoFactorChallenge
+	resp.TwoFactorSetup = respRegisterUser.TwoFactorSetup
+	resp.RiskVerify = respRegisterUser.RiskVerify
+	resp.PasswordChange = respRegisterUser.PasswordChange
 	log.ZInfo(c, "registerUser api", "resp", &resp)
 	apiresp.GinSuccess(c, &resp)
 }
 
 func (o *ChatApi) Login(c *gin.Context) {
-	var (
-		req  chat.LoginReq
-		resp apistruct.LoginResp
-	)
+	var req chat.LoginReq
 	if err := c.BindJSON(&req); err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -152,18 +167,43 @@ func (o *ChatApi) Login(c *gin.Context) {
 		apiresp.GinError(c, err) // 参数校验失败
 		return
 	}
+	if req.LdapPassword != "" && !o.isTLS(c) {
+		apiresp.GinError(c, errs.ErrNoPermission.Wrap("ldap login requires https"))
+		return
+	}
 	ip, err := o.getClientIP(c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 	req.Ip = ip
-	resp1, err := o.chatClient.Login(c, &req)
+	resp, err := o.chatClient.Login(c, &req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, req.Platform)
+	o.loginResp(c, resp, req.Platform)
+}
+
+// loginResp answers a login, adding the im token unless the second factor is still pending or the password has to be changed.
+func (o *ChatApi) loginResp(c *gin.Context, resp1 *chat.LoginResp, platform int32) {
+	var resp apistruct.LoginResp
+	if resp1.TwoFactorChallenge != "" {
+		resp.UserID = resp1.UserID
+		resp.TwoFactorChallenge = resp1.TwoFactorChallenge
+		resp.TwoFactorSetup = resp1.TwoFactorSetup
+		resp.RiskVerify = resp1.RiskVerify
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	if resp1.PasswordChange {
+		resp.UserID = resp1.UserID
+		resp.ChatToken = resp1.ChatToken
+		resp.PasswordChange = true
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, platform)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -171,9 +211,321 @@ func (o *ChatApi) Login(c *gin.Context) {
 	resp.ImToken = imToken
 	resp.UserID = resp1.UserID
 	resp.ChatToken = resp1.ChatToken
+	resp.RefreshToken = resp1.RefreshToken
+	apiresp.GinSuccess(c, resp)
+}
+
+// SendLoginCode sends the verify code of a passwordless login.
+func (o *ChatApi) SendLoginCode(c *gin.Context) {
+	var req chat.SendVerifyCodeReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UsedFor = constant2.VerificationCodeForLogin
+	resp, err := o.chatClient.SendVerifyCode(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 	apiresp.GinSuccess(c, resp)
 }
 
+// SendChangeIdentityCode sends the verify code to the new or the current phone number or email.
+func (o *ChatApi) SendChangeIdentityCode(c *gin.Context) {
+	var req chat.SendVerifyCodeReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UsedFor = constant2.VerificationCodeForChangeIdentity
+	resp, err := o.chatClient.SendVerifyCode(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) ChangeIdentity(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ChangeIdentity, o.chatClient, c)
+}
+
+func (o *ChatApi) SendLoginLink(c *gin.Context) {
+	var req chat.SendLoginLinkReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.SendLoginLink(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) GetOIDCAuthURL(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetOIDCAuthURL, o.chatClient, c)
+}
+
+func (o *ChatApi) LoginByOIDC(c *gin.Context) {
+	var req chat.LoginByOIDCReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.LoginByOIDC(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.loginResp(c, resp, req.Platform)
+}
+
+func (o *ChatApi) LoginByLink(c *gin.Context) {
+	var req chat.LoginByLinkReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	resp, err := o.chatClient.LoginByLink(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	o.loginResp(c, resp, req.Platform)
+}
+
+func (o *ChatApi) TwoFactorLogin(c *gin.Context) {
+	var (
+		req  chat.TwoFactorLoginReq
+		resp apistruct.LoginResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	resp1, err := o.chatClient.TwoFactorLogin(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp.UserID = resp1.UserID
+	resp.ChatToken = resp1.ChatToken
+	resp.RecoveryCodes = resp1.RecoveryCodes
+	if resp1.PasswordChange {
+		resp.PasswordChange = true
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, resp1.Platform)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp.ImToken = imToken
+	resp.RefreshToken = resp1.RefreshToken
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) RefreshToken(c *gin.Context) {
+	var req admin.RefreshTokenReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UserType = constant2.NormalUser
+	resp, err := o.adminClient.RefreshToken(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) GetSessions(c *gin.Context) {
+	req := admin.GetSessionsReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token")}
+	resp, err := o.adminClient.GetSessions(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) RevokeSessions(c *gin.Context) {
+	var req admin.RevokeSessionsReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserID = mctx.GetOpUserID(c)
+	req.Token = c.GetHeader("token")
+	req.Current = false
+	o.revokeSessions(c, &req)
+}
+
+func (o *ChatApi) Logout(c *gin.Context) {
+	o.revokeSessions(c, &admin.RevokeSessionsReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token"), Current: true})
+}
+
+func (o *ChatApi) revokeSessions(c *gin.Context, req *admin.RevokeSessionsReq) {
+	if err := o.revoke(c, req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+// revoke revokes the sessions and kicks their platforms in im.
+func (o *ChatApi) revoke(c *gin.Context, req *admin.RevokeSessionsReq) error {
+	resp, err := o.adminClient.RevokeSessions(c, req)
+	if err != nil {
+		return err
+	}
+	if len(resp.Platforms) > 0 {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			return err
+		}
+		if err := o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), req.UserID, resp.Platforms...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAccount schedules the deletion and signs the user out of every session.
+func (o *ChatApi) DeleteAccount(c *gin.Context) {
+	var req chat.DeleteAccountReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.chatClient.DeleteAccount(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.revoke(c, &admin.RevokeSessionsReq{UserID: mctx.GetOpUserID(c), Others: true}); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) StartDataExport(c *gin.Context) {
+	a2r.Call(chat.ChatClient.StartDataExport, o.chatClient, c)
+}
+
+func (o *ChatApi) GetDataExport(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetDataExport, o.chatClient, c)
+}
+
+// DownloadDataExport streams the zip of the signed url from dataExport.dir, chat-rpc only checks the token.
+func (o *ChatApi) DownloadDataExport(c *gin.Context) {
+	// opened by the browser, so the operationID header is usually missing
+	operationID := c.GetHeader(constant.OperationID)
+	if operationID == "" {
+		operationID = "data_export_download_" + strconv.FormatInt(time.Now().UnixMilli(), 10)
+	}
+	c.Set(constant.OperationID, operationID)
+	resp, err := o.chatClient.DownloadDataExport(c, &chat.DownloadDataExportReq{Token: c.Query("token")})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	path, err := dataexport.Path(dataexport.Dir(config.Config.DataExport.Dir), resp.ExportID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		log.ZError(c, "open data export failed", err, "exportID", resp.ExportID)
+		apiresp.GinError(c, errs.ErrArgs.Wrap("export is not available"))
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		apiresp.GinError(c, errs.Wrap(err))
+		return
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.FileName}))
+	c.Header("Content-Type", "application/zip")
+	http.ServeContent(c.Writer, c.Request, "", info.ModTime(), f)
+}
+
+func (o *ChatApi) GetTwoFactorStatus(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetTwoFactorStatus, o.chatClient, c)
+}
+
+func (o *ChatApi) SetupTwoFactor(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SetupTwoFactor, o.chatClient, c)
+}
+
+func (o *ChatApi) EnableTwoFactor(c *gin.Context) {
+	a2r.Call(chat.ChatClient.EnableTwoFactor, o.chatClient, c)
+}
+
+func (o *ChatApi) DisableTwoFactor(c *gin.Context) {
+	a2r.Call(chat.ChatClient.DisableTwoFactor, o.chatClient, c)
+}
+
+func (o *ChatApi) GenTwoFactorRecoveryCodes(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GenTwoFactorRecoveryCodes, o.chatClient, c)
+}
+
 func (o *ChatApi) ResetPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ResetPassword, o.chatClient, c)
 }
@@ -184,6 +536,10 @@ func (o *ChatApi) ChangePassword(c *gin.Context) {
 
 // ################## USER ##################
 
+func (o *ChatApi) GetLoginHistory(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SearchUserLoginRecord, o.chatClient, c)
+}
+
 func (o *ChatApi) UpdateUserInfo(c *gin.Context) {
 	var (
 		req  chat.UpdateUserInfoReq
@@ -306,6 +662,14 @@ func (o *ChatApi) OpenIMCallback(c *gin.Context) {
 	apiresp.GinSuccess(c, nil)
 }
 
+// isTLS reports whether the client connected over https, behind a proxy only X-Forwarded-Proto tells.
+func (o *ChatApi) isTLS(c *gin.Context) bool {
+	if c.Request.TLS != nil {
+		return true
+	}
+	return config.Config.ProxyHeader != "" && strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+}
+
 func (o *ChatApi) getClientIP(c *gin.Context) (string, error) {
 	if config.Config.ProxyHeader == "" {
 		ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
@@ -363,3 +727,31 @@ func (o *ChatApi) SearchFriend(c *gin.Context) {
 func (o *ChatApi) DeleteLogs(c *gin.Context) {
 	a2r.Call(chat.ChatClient.DeleteLogs, o.chatClient, c)
 }
+
+// GetJWKS publishes the public keys of the token signing keys.
+func (o *ChatApi) GetJWKS(c *gin.Context) {
+	writeJWKS(c, o.adminClient)
+}
+
+func writeJWKS(c *gin.Context, client admin.AdminClient) {
+	resp, err := client.GetJWKS(c, &admin.GetJWKSReq{})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	keys := make([]*tokenverify.JWK, 0, len(resp.Keys))
+	for _, key := range resp.Keys {
+		keys = append(keys, &tokenverify.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Alg: key.Alg,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	c.Header("Cache-Control", "public, max-age=60")
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
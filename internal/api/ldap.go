@@ -0,0 +1,445 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/wrapperspb"
+	"github.com/OpenIMSDK/tools/apiresp"
+	"github.com/OpenIMSDK/tools/discoveryregistry"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/chat/pkg/common/apistruct"
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/common/directory"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+	"github.com/OpenIMSDK/chat/pkg/proto/common"
+	"github.com/OpenIMSDK/chat/pkg/proto/organization"
+)
+
+const (
+	// ldapSyncRunning is held while a sync runs, whether scheduled or started by an admin.
+	ldapSyncRunning = "ldap_sync"
+	// ldapSyncScheduled is kept for the sync interval, so one replica runs the scheduled sync per interval.
+	ldapSyncScheduled  = "ldap_sync_scheduled"
+	ldapSyncLockExpire = time.Hour
+)
+
+// LDAPSync mirrors the directory into the organization, a redis lock keeps the replicas of admin-api from syncing at the same time.
+type LDAPSync struct {
+	org  *Org
+	lock cache.SyncLockInterface
+}
+
+// NewLDAPSync connects to redis only if ldap is enabled.
+func NewLDAPSync(discov discoveryregistry.SvcDiscoveryRegistry) (*LDAPSync, error) {
+	if !config.Config.LDAP.Enable {
+		return &LDAPSync{}, nil
+	}
+	chatConn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImChatName)
+	if err != nil {
+		return nil, err
+	}
+	adminConn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImAdminName)
+	if err != nil {
+		return nil, err
+	}
+	orgConn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImOrganizationName)
+	if err != nil {
+		return nil, err
+	}
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return nil, err
+	}
+	return &LDAPSync{org: NewOrg(chatConn, adminConn, orgConn), lock: cache.NewSyncLockInterface(rdb)}, nil
+}
+
+func (l *LDAPSync) Sync(c *gin.Context) {
+	resp, err := l.sync(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// Loop runs the sync every syncInterval minutes on one of the replicas.
+func (l *LDAPSync) Loop() {
+	interval := time.Duration(config.Config.LDAP.SyncInterval) * time.Minute
+	if l.org == nil || interval <= 0 {
+		return
+	}
+	for {
+		ctx := mctx.WithAdminUser(mcontext.NewCtx("ldap_sync_" + strconv.FormatInt(time.Now().UnixMilli(), 10)))
+		token, err := l.lock.TryLock(ctx, ldapSyncScheduled, interval)
+		if err != nil {
+			log.ZError(ctx, "ldap sync lock failed", err)
+		} else if token != "" {
+			resp, err := l.sync(ctx)
+			if err != nil {
+				log.ZError(ctx, "ldap sync failed", err)
+			} else {
+				log.ZInfo(ctx, "ldap sync", "result", resp)
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (l *LDAPSync) sync(ctx context.Context) (*apistruct.LDAPSyncResp, error) {
+	if l.org == nil {
+		return nil, errs.ErrArgs.Wrap("ldap disabled")
+	}
+	token, err := l.lock.TryLock(ctx, ldapSyncRunning, ldapSyncLockExpire)
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, errs.ErrNoPermission.Wrap("ldap sync already running")
+	}
+	defer func() {
+		if err := l.lock.Unlock(ctx, ldapSyncRunning, token); err != nil {
+			log.ZError(ctx, "ldap sync unlock failed", err)
+		}
+	}()
+	return l.org.ldapSync(ctx)
+}
+
+// ldapSync mirrors organizational units into departments and people into users and department members.
+// Users who left the directory are terminated in their departments, they can no longer log in since the ldap bind fails.
+// Nothing is deleted or terminated unless the directory was read completely and every department was synced.
+func (o *Org) ldapSync(ctx context.Context) (*apistruct.LDAPSyncResp, error) {
+	dir := directory.NewFromConfig()
+	if dir == nil {
+		return nil, errs.ErrArgs.Wrap("ldap disabled")
+	}
+	departments, users, err := dir.Load()
+	if err != nil {
+		return nil, err
+	}
+	resp := &apistruct.LDAPSyncResp{}
+	departmentIDs, err := o.ldapSyncDepartments(ctx, departments, resp)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.ldapSyncUsers(ctx, users, departmentIDs, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ldapSyncDepartments returns the department id of every normalized organizational unit dn.
+func (o *Org) ldapSyncDepartments(ctx context.Context, departments []*directory.Department, resp *apistruct.LDAPSyncResp) (map[string]string, error) {
+	treeResp, err := o.organizationClient.GetOrganizationDepartment(ctx, &organization.GetOrganizationDepartmentReq{})
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*organization.DepartmentNum)
+	var walk func(infos []*organization.DepartmentInfo)
+	walk = func(infos []*organization.DepartmentInfo) {
+		for _, info := range infos {
+			if info.Department != nil {
+				existing[info.Department.DepartmentID] = info.Department
+			}
+			walk(info.Subdepartments)
+		}
+	}
+	walk(treeResp.Departments)
+	departmentIDs := make(map[string]string)
+	for _, department := range departments {
+		dn, err := directory.NormalizeDN(department.DN)
+		if err != nil {
+			return nil, err
+		}
+		departmentIDs[dn], err = directory.DepartmentID(department.DN)
+		if err != nil {
+			return nil, err
+		}
+	}
+	synced := make(map[string]struct{})
+	for _, department := range departments {
+		dn, _ := directory.NormalizeDN(department.DN)
+		departmentID := departmentIDs[dn]
+		parentDN, err := directory.ParentDN(department.DN)
+		if err != nil {
+			return nil, err
+		}
+		parentID := departmentIDs[parentDN]
+		if cur, ok := existing[departmentID]; ok {
+			synced[departmentID] = struct{}{}
+			if cur.Name == department.Name && cur.ParentDepartmentID == parentID {
+				continue
+			}
+			_, err := o.organizationClient.UpdateDepartment(ctx, &organization.UpdateDepartmentReq{
+				DepartmentID:       departmentID,
+				Name:               wrapperspb.String(department.Name),
+				ParentDepartmentID: wrapperspb.String(parentID),
+			})
+			if err != nil {
+				log.ZError(ctx, "ldap sync update department failed", err, "dn", department.DN)
+				resp.Failed++
+				continue
+			}
+			resp.DepartmentUpdated++
+			continue
+		}
+		if parentID != "" {
+			if _, ok := synced[parentID]; !ok {
+				log.ZWarn(ctx, "ldap sync parent department missing", nil, "dn", department.DN)
+				resp.Failed++
+				continue
+			}
+		}
+		_, err = o.organizationClient.CreateDepartment(ctx, &organization.CreateDepartmentReq{
+			DepartmentID:       departmentID,
+			Name:               department.Name,
+			ParentDepartmentID: parentID,
+		})
+		if err != nil {
+			log.ZError(ctx, "ldap sync create department failed", err, "dn", department.DN)
+			resp.Failed++
+			continue
+		}
+		synced[departmentID] = struct{}{}
+		resp.DepartmentCreated++
+	}
+	var deleteIDs []string
+	for departmentID := range existing {
+		if _, ok := synced[departmentID]; !ok && directory.IsDepartmentID(departmentID) {
+			deleteIDs = append(deleteIDs, departmentID)
+		}
+	}
+	// an empty read, e.g. of a wrong base dn, or a partial sync must not remove the organization
+	if len(deleteIDs) > 0 && (resp.Failed > 0 || len(departments) == 0) {
+		log.ZWarn(ctx, "ldap sync skips deleting departments", nil, "departmentIDs", deleteIDs, "failed", resp.Failed)
+		resp.DeletionSkipped = true
+		return departmentIDs, nil
+	}
+	if len(deleteIDs) > 0 {
+		if _, err := o.organizationClient.DeleteDepartment(ctx, &organization.DeleteDepartmentReq{DepartmentIDs: deleteIDs}); err != nil {
+			log.ZError(ctx, "ldap sync delete department failed", err, "departmentIDs", deleteIDs)
+			resp.Failed++
+		} else {
+			resp.DepartmentDeleted += len(deleteIDs)
+		}
+	}
+	return departmentIDs, nil
+}
+
+func (o *Org) ldapSyncUsers(ctx context.Context, users []*directory.User, departmentIDs map[string]string, resp *apistruct.LDAPSyncResp) error {
+	identityResp, err := o.chatClient.FindExternalIdentity(ctx, &chat.FindExternalIdentityReq{Provider: directory.Provider})
+	if err != nil {
+		return err
+	}
+	subjectUserID := make(map[string]string)
+	for _, identity := range identityResp.Identities {
+		subjectUserID[identity.Subject] = identity.UserID
+	}
+	fullInfos := make(map[string]*common.UserFullInfo)
+	members := make(map[string][]*organization.MemberDepartment)
+	if len(subjectUserID) > 0 {
+		userIDs := utils.Values(subjectUserID)
+		fullResp, err := o.chatClient.FindUserFullInfo(ctx, &chat.FindUserFullInfoReq{UserIDs: userIDs})
+		if err != nil {
+			return err
+		}
+		for _, user := range fullResp.Users {
+			fullInfos[user.UserID] = user
+		}
+		memberResp, err := o.organizationClient.GetUserInDepartment(ctx, &organization.GetUserInDepartmentReq{UserIDs: userIDs})
+		if err != nil {
+			return err
+		}
+		for _, user := range memberResp.Users {
+			if user.User != nil {
+				members[user.User.UserID] = user.Members
+			}
+		}
+	}
+	now := time.Now().UnixMilli()
+	seen := make(map[string]struct{})
+	for _, user := range users {
+		parentDN, err := directory.ParentDN(user.DN)
+		if err != nil {
+			return err
+		}
+		departmentID := departmentIDs[parentDN]
+		userID, ok := subjectUserID[user.ID]
+		if !ok {
+			req := &registerUserReq{User: chat.RegisterUserInfo{
+				Account:     user.Account,
+				Nickname:    user.Nickname,
+				Email:       user.Email,
+				Telephone:   user.Telephone,
+				Station:     user.Station,
+				EnglishName: user.EnglishName,
+			}}
+			if departmentID != "" {
+				req.Departments = []registerUserDepartment{{DepartmentID: departmentID, Position: user.Station, EntryTime: now}}
+			}
+			userID, err := o.registerUser(ctx, req, "")
+			if err != nil {
+				log.ZError(ctx, "ldap sync register user failed", err, "dn", user.DN)
+				resp.Failed++
+				continue
+			}
+			identity := &chat.ExternalIdentity{Provider: directory.Provider, Subject: user.ID, UserID: userID, Email: user.Email}
+			if _, err := o.chatClient.AddExternalIdentity(ctx, &chat.AddExternalIdentityReq{Identity: identity}); err != nil {
+				log.ZError(ctx, "ldap sync add identity failed", err, "dn", user.DN, "userID", userID)
+				resp.Failed++
+				continue
+			}
+			resp.UserCreated++
+			continue
+		}
+		seen[userID] = struct{}{}
+		info, ok := fullInfos[userID]
+		if !ok {
+			continue
+		}
+		updated, err := o.ldapUpdateUser(ctx, info, user)
+		if err != nil {
+			log.ZError(ctx, "ldap sync update user failed", err, "dn", user.DN, "userID", userID)
+			resp.Failed++
+			continue
+		}
+		moved, err := o.ldapUpdateMember(ctx, userID, departmentID, members[userID], user.Station, now)
+		if err != nil {
+			log.ZError(ctx, "ldap sync update member failed", err, "dn", user.DN, "userID", userID)
+			resp.Failed++
+			continue
+		}
+		if updated || moved {
+			resp.UserUpdated++
+		}
+	}
+	if len(users) == 0 && len(subjectUserID) > 0 {
+		log.ZWarn(ctx, "ldap sync skips terminating users, the directory returned none", nil)
+		resp.DeletionSkipped = true
+		return nil
+	}
+	for _, userID := range subjectUserID {
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		var terminated bool
+		for _, member := range members[userID] {
+			if member.TerminationTime != 0 {
+				continue
+			}
+			_, err := o.organizationClient.UpdateUserInDepartment(ctx, &organization.UpdateUserInDepartmentReq{
+				UserID:          userID,
+				DepartmentID:    member.DepartmentID,
+				TerminationTime: wrapperspb.Int64(now),
+			})
+			if err != nil {
+				log.ZError(ctx, "ldap sync terminate member failed", err, "userID", userID, "departmentID", member.DepartmentID)
+				resp.Failed++
+				continue
+			}
+			terminated = true
+		}
+		if terminated {
+			resp.UserTerminated++
+		}
+	}
+	return nil
+}
+
+// ldapUpdateUser copies the changed directory attributes onto the user.
+func (o *Org) ldapUpdateUser(ctx context.Context, info *common.UserFullInfo, user *directory.User) (bool, error) {
+	req := &chat.UpdateUserInfoReq{UserID: info.UserID}
+	var changed bool
+	set := func(field **wrapperspb.StringValue, cur string, val string) {
+		if cur != val {
+			*field = wrapperspb.String(val)
+			changed = true
+		}
+	}
+	set(&req.Nickname, info.Nickname, user.Nickname)
+	set(&req.Email, info.Email, user.Email)
+	set(&req.Telephone, info.Telephone, user.Telephone)
+	set(&req.Station, info.Station, user.Station)
+	set(&req.EnglishName, info.EnglishName, user.EnglishName)
+	if !changed {
+		return false, nil
+	}
+	if _, err := o.chatClient.UpdateUserInfo(ctx, req); err != nil {
+		return false, err
+	}
+	if req.Nickname != nil {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(ctx)
+		if err != nil {
+			return false, err
+		}
+		if err := o.imApiCaller.UpdateUserInfo(mctx.WithApiToken(ctx, imToken), info.UserID, user.Nickname, info.FaceURL); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ldapUpdateMember keeps the user in the department of its organizational unit only.
+func (o *Org) ldapUpdateMember(ctx context.Context, userID string, departmentID string, members []*organization.MemberDepartment, position string, now int64) (bool, error) {
+	var changed, found bool
+	for _, member := range members {
+		if member.DepartmentID == departmentID {
+			found = true
+			if member.TerminationTime != 0 {
+				_, err := o.organizationClient.UpdateUserInDepartment(ctx, &organization.UpdateUserInDepartmentReq{
+					UserID:          userID,
+					DepartmentID:    departmentID,
+					EntryTime:       wrapperspb.Int64(now),
+					TerminationTime: wrapperspb.Int64(0),
+				})
+				if err != nil {
+					return false, err
+				}
+				changed = true
+			}
+			continue
+		}
+		if directory.IsDepartmentID(member.DepartmentID) {
+			_, err := o.organizationClient.DeleteUserInDepartment(ctx, &organization.DeleteUserInDepartmentReq{UserID: userID, DepartmentID: member.DepartmentID})
+			if err != nil {
+				return false, err
+			}
+			changed = true
+		}
+	}
+	if !found && departmentID != "" {
+		_, err := o.organizationClient.CreateDepartmentMember(ctx, &organization.CreateDepartmentMemberReq{
+			UserID:       userID,
+			DepartmentID: departmentID,
+			Position:     position,
+			EntryTime:    now,
+		})
+		if err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	return changed, nil
+}
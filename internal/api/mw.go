@@ -15,8 +15,12 @@
 package api
 
 import (
+	"fmt"
+	"net"
 	"strconv"
+	"strings"
 
+	"github.com/OpenIMSDK/chat/pkg/common/config"
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	constant2 "github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/tools/apiresp"
@@ -37,6 +41,11 @@ type MW struct {
 }
 
 func (o *MW) parseToken(c *gin.Context) (string, int32, string, error) {
+	return o.parseScopeToken(c, "")
+}
+
+// parseScopeToken also accepts tokens limited to scope, tokens limited to other scopes are rejected.
+func (o *MW) parseScopeToken(c *gin.Context, scope string) (string, int32, string, error) {
 	token := c.GetHeader("token")
 	if token == "" {
 		return "", 0, "", errs.ErrArgs.Wrap("token is empty")
@@ -45,6 +54,9 @@ func (o *MW) parseToken(c *gin.Context) (string, int32, string, error) {
 	if err != nil {
 		return "", 0, "", err
 	}
+	if resp.Scope != "" && resp.Scope != scope {
+		return "", 0, "", errs.ErrNoPermission.Wrap("token is limited to " + resp.Scope)
+	}
 	return resp.UserID, resp.UserType, token, nil
 }
 
@@ -60,7 +72,8 @@ func (o *MW) parseTokenType(c *gin.Context, userType int32) (string, string, err
 }
 
 func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
-	resp, err := o.client.GetUserToken(c, &admin.GetUserTokenReq{UserID: userID})
+	ip, _ := o.getClientIP(c)
+	resp, err := o.client.GetUserToken(c, &admin.GetUserTokenReq{UserID: userID, Token: token, Ip: ip})
 	m := resp.TokensMap
 	if err != nil {
 		log.ZWarn(c, "cache get token error", errs.ErrTokenNotExist.Wrap())
@@ -86,6 +99,22 @@ func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
 	return nil
 }
 
+func (o *MW) getClientIP(c *gin.Context) (string, error) {
+	return getClientIP(c)
+}
+
+func getClientIP(c *gin.Context) (string, error) {
+	if config.Config.ProxyHeader == "" {
+		ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+		return ip, err
+	}
+	ip := c.Request.Header.Get(config.Config.ProxyHeader)
+	if net.ParseIP(ip) == nil {
+		return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("parse proxy ip header %s failed", ip))
+	}
+	return ip, nil
+}
+
 func (o *MW) setToken(c *gin.Context, userID string, userType int32) {
 	SetToken(c, userID, userType)
 }
@@ -105,7 +134,30 @@ func (o *MW) CheckToken(c *gin.Context) {
 	o.setToken(c, userID, userType)
 }
 
+// CheckPasswordChange is CheckToken that also accepts the token issued while the password has to be changed.
+func (o *MW) CheckPasswordChange(c *gin.Context) {
+	userID, userType, token, err := o.parseScopeToken(c, constant.TokenScopePassword)
+	if err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.isValidToken(c, userID, token); err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+	o.setToken(c, userID, userType)
+}
+
 func (o *MW) CheckAdmin(c *gin.Context) {
+	if apiKey := c.GetHeader(ApiKeyHeader); apiKey != "" {
+		if err := o.checkApiKey(c, apiKey); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+		}
+		return
+	}
 	userID, token, err := o.parseTokenType(c, constant.AdminUser)
 	if err != nil {
 		c.Abort()
@@ -136,6 +188,13 @@ func (o *MW) CheckUser(c *gin.Context) {
 }
 
 func (o *MW) CheckAdminOrNil(c *gin.Context) {
+	if apiKey := c.GetHeader(ApiKeyHeader); apiKey != "" {
+		if err := o.checkApiKey(c, apiKey); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+		}
+		return
+	}
 	defer c.Next()
 	userID, userType, _, err := o.parseToken(c)
 	if err != nil {
@@ -149,5 +208,27 @@ func (o *MW) CheckAdminOrNil(c *gin.Context) {
 func SetToken(c *gin.Context, userID string, userType int32) {
 	c.Set(constant.RpcOpUserID, userID)
 	c.Set(constant.RpcOpUserType, []string{strconv.Itoa(int(userType))})
-	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType})
+	headers := append(c.GetStringSlice(constant.RpcCustomHeader), constant.RpcOpUserType)
+	// the ip is recorded in the audit log
+	if ip, err := getClientIP(c); err == nil && ip != "" {
+		c.Set(constant.RpcOpIP, []string{ip})
+		headers = append(headers, constant.RpcOpIP)
+	}
+	c.Set(constant.RpcCustomHeader, headers)
+}
+
+// maxLanguageLen 足够放下几个带权重的语言
+const maxLanguageLen = 128
+
+// Language forwards the Accept-Language of the request, the mails sent by the rpc are localized with it.
+func Language(c *gin.Context) {
+	language := strings.TrimSpace(c.GetHeader("Accept-Language"))
+	if len(language) > maxLanguageLen {
+		language = language[:maxLanguageLen]
+	}
+	if language != "" {
+		c.Set(constant.RpcOpLanguage, []string{language})
+		c.Set(constant.RpcCustomHeader, append(c.GetStringSlice(constant.RpcCustomHeader), constant.RpcOpLanguage))
+	}
+	c.Next()
 }
@@ -0,0 +1,61 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/OpenIMSDK/tools/a2r"
+	"github.com/OpenIMSDK/tools/apiresp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+// CheckPermission must be used after CheckAdmin, it rejects admins whose roles do not grant the permission.
+func (o *MW) CheckPermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// api keys only hold the narrow permissions of their scopes, checked again by the rpcs, the scope of the route is enough here.
+		if _, ok := c.Get(apiKeyScopeKey); ok {
+			return
+		}
+		if _, err := o.client.CheckPermission(c, &admin.CheckPermissionReq{Permission: permission}); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+		}
+	}
+}
+
+func (o *AdminApi) AddRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddRole, o.adminClient, c)
+}
+
+func (o *AdminApi) UpdateRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.UpdateRole, o.adminClient, c)
+}
+
+func (o *AdminApi) DelRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelRole, o.adminClient, c)
+}
+
+func (o *AdminApi) SearchRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchRole, o.adminClient, c)
+}
+
+func (o *AdminApi) SetAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetAdminRole, o.adminClient, c)
+}
+
+func (o *AdminApi) FindAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.FindAdminRole, o.adminClient, c)
+}
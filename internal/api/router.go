@@ -19,6 +19,7 @@ import (
 	"github.com/OpenIMSDK/chat/example/callback"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"github.com/gin-gonic/gin"
 )
@@ -44,13 +45,46 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 	chat := NewChat(chatConn, adminConn)
 	org := NewOrg(chatConn, adminConn, orgConn)
 
+	router.GET("/.well-known/jwks.json", chat.GetJWKS) // Public keys of the token signing keys
+
 	account := router.Group("/account")
 	//account.POST("/code/send", chat.SendVerifyCode)                      // Send verification code
 	//account.POST("/code/verify", chat.VerifyCode)                        // Verify the verification code
 	//account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser)     // Register
-	account.POST("/login", chat.Login)                                   // Login
-	account.POST("/password/reset", chat.ResetPassword)                  // Forgot password
-	account.POST("/password/change", mw.CheckToken, chat.ChangePassword) // Change password
+	account.POST("/login", chat.Login)                                            // Login
+	account.POST("/password/reset", chat.ResetPassword)                           // Forgot password
+	account.POST("/password/change", mw.CheckPasswordChange, chat.ChangePassword) // Change password, also with the token of a login that has to change it
+	account.POST("/login/2fa", chat.TwoFactorLogin)                               // Complete login with the second factor
+	account.POST("/login/code/send", chat.SendLoginCode)                          // Send the verify code of a passwordless login
+	account.POST("/captcha", chat.GetCaptcha)                                     // Challenge answered when sending a verify code is throttled
+	account.POST("/login/link/send", chat.SendLoginLink)                          // Email a one-time login link
+	account.POST("/login/link", chat.LoginByLink)                                 // Login with the token of a login link
+	account.POST("/oidc/authorize", chat.GetOIDCAuthURL)                          // Authorization url of an OpenID Connect provider
+	account.POST("/oidc/login", chat.LoginByOIDC)                                 // Login with the code returned by the provider
+	account.POST("/login/2fa/setup", chat.SetupTwoFactor)                         // Set up two-factor authentication required at login
+	account.POST("/token/refresh", chat.RefreshToken)                             // Exchange a refresh token for a new token pair
+	account.POST("/logout", mw.CheckUser, chat.Logout)                            // Revoke the current session
+	account.POST("/delete", mw.CheckUser, chat.DeleteAccount)                     // Schedule the deletion of the account, logging in again cancels it
+
+	session := account.Group("/session", mw.CheckUser)
+	session.POST("/list", chat.GetSessions)      // Sessions of the user with their devices
+	session.POST("/revoke", chat.RevokeSessions) // Revoke sessions, others signs out every other device
+
+	identity := account.Group("/identity", mw.CheckUser)
+	identity.POST("/code/send", chat.SendChangeIdentityCode) // Send the verify code to the new or the current phone number or email
+	identity.POST("/change", chat.ChangeIdentity)            // Change the login phone number or email
+
+	export := account.Group("/export")
+	export.POST("/start", mw.CheckUser, chat.StartDataExport) // Start exporting the personal data as a zip
+	export.POST("/get", mw.CheckUser, chat.GetDataExport)     // Status of an export with its download url
+	export.GET("/download", chat.DownloadDataExport)          // Download through the signed url, no token needed
+
+	twoFactor := account.Group("/2fa", mw.CheckUser)
+	twoFactor.POST("/status", chat.GetTwoFactorStatus)                // Two-factor authentication status
+	twoFactor.POST("/setup", chat.SetupTwoFactor)                     // Generate secret and provisioning URI
+	twoFactor.POST("/enable", chat.EnableTwoFactor)                   // Confirm the first code and get recovery codes
+	twoFactor.POST("/disable", chat.DisableTwoFactor)                 // Disable two-factor authentication
+	twoFactor.POST("/recovery_codes", chat.GenTwoFactorRecoveryCodes) // Regenerate recovery codes
 
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)             // Edit personal information
@@ -58,6 +92,7 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 	user.POST("/find/full", org.FindUserFullInfo)         // Get all information of the user
 	user.POST("/search/full", org.SearchUserFullInfo)     // Search user's public information
 	user.POST("/search/public", org.SearchUserPublicInfo) // Search all information of the user
+	user.POST("/login/history", chat.GetLoginHistory)     // Recent logins of the user
 
 	router.POST("/friend/search", mw.CheckToken, chat.SearchFriend)
 
@@ -126,7 +161,7 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 
 }
 
-func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegistry) {
+func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegistry, ldapSync *LDAPSync) {
 	adminConn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImAdminName)
 	if err != nil {
 		panic(err)
@@ -147,23 +182,55 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	org := NewOrg(chatConn, adminConn, orgConn)
 
 	admin := NewAdmin(chatConn, adminConn, orgConn, rtcConn)
+	router.GET("/.well-known/jwks.json", admin.GetJWKS) // Public keys of the token signing keys
+
 	adminRouterGroup := router.Group("/account")
-	adminRouterGroup.POST("/login", admin.AdminLogin)                                   // Login
-	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)              // Modify information
-	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                      // Get information
-	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword) // Change admin account's password
-	adminRouterGroup.POST("/add_admin", mw.CheckAdmin, admin.AddAdminAccount)           // Add admin account
-	adminRouterGroup.POST("/add_user", mw.CheckAdmin, admin.AddUserAccount)             // Add user account
-	adminRouterGroup.POST("/del_admin", mw.CheckAdmin, admin.DelAdminAccount)           // Delete admin
-	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)           // Get admin list
+	adminRouterGroup.POST("/login", admin.AdminLogin)                                                                    // Login
+	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)                                               // Modify information
+	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                                                       // Get information
+	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword)                                  // Change admin account's password
+	adminRouterGroup.POST("/add_admin", mw.CheckAdmin, admin.AddAdminAccount)                                            // Add admin account
+	adminRouterGroup.POST("/add_user", mw.CheckAdmin, mw.CheckPermission(constant.PermissionUser), admin.AddUserAccount) // Add user account
+	adminRouterGroup.POST("/del_admin", mw.CheckAdmin, admin.DelAdminAccount)                                            // Delete admin
+	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)                                            // Get admin list
+	adminRouterGroup.POST("/login/2fa", admin.AdminTwoFactorLogin)                                                       // Complete login with the second factor
+	adminRouterGroup.POST("/login/2fa/setup", admin.SetupTwoFactor)                                                      // Set up two-factor authentication required at login
+	adminRouterGroup.POST("/token/refresh", admin.RefreshToken)                                                          // Exchange a refresh token for a new token pair
+	adminRouterGroup.POST("/logout", mw.CheckAdmin, admin.Logout)                                                        // Revoke the current session
+	adminRouterGroup.POST("/login/unlock", mw.CheckAdmin, admin.UnlockAdminLogin)                                        // Super admin unlocks admin accounts or ips locked after wrong passwords
+
+	adminTwoFactor := adminRouterGroup.Group("/2fa", mw.CheckAdmin)
+	adminTwoFactor.POST("/setup", admin.SetupTwoFactor)                     // Generate secret and provisioning URI
+	adminTwoFactor.POST("/enable", admin.EnableTwoFactor)                   // Confirm the first code and get recovery codes
+	adminTwoFactor.POST("/disable", admin.DisableTwoFactor)                 // Disable two-factor authentication
+	adminTwoFactor.POST("/recovery_codes", admin.GenTwoFactorRecoveryCodes) // Regenerate recovery codes
+	adminTwoFactor.POST("/reset", admin.ResetAdminTwoFactor)                // Super admin resets another admin's two-factor authentication
+
+	adminLoginIPRouter := router.Group("/security/login_ip", mw.CheckAdmin)
+	adminLoginIPRouter.POST("/add", admin.AddAdminLoginIP)       // Add admin login allowlist ip
+	adminLoginIPRouter.POST("/del", admin.DelAdminLoginIP)       // Delete admin login allowlist ip
+	adminLoginIPRouter.POST("/search", admin.SearchAdminLoginIP) // Search admin login allowlist ip
 	//account.POST("/add_notification_account")
 
+	apiKeyRouter := router.Group("/api_key", mw.CheckAdmin)
+	apiKeyRouter.POST("/add", admin.AddApiKey)       // Add api key, the secret is only returned once
+	apiKeyRouter.POST("/search", admin.SearchApiKey) // Search api keys
+	apiKeyRouter.POST("/del", admin.DelApiKey)       // Delete api keys
+
+	roleRouter := router.Group("/role", mw.CheckAdmin)
+	roleRouter.POST("/add", admin.AddRole)              // Add role with permissions on route groups
+	roleRouter.POST("/update", admin.UpdateRole)        // Modify role
+	roleRouter.POST("/del", admin.DelRole)              // Delete roles and their assignments
+	roleRouter.POST("/search", admin.SearchRole)        // Search roles
+	roleRouter.POST("/assign", admin.SetAdminRole)      // Replace the roles of an admin
+	roleRouter.POST("/admin/find", admin.FindAdminRole) // Roles of admins
+
 	importGroup := router.Group("/user/import")
 	importGroup.POST("/json", mw.CheckAdminOrNil, admin.ImportUserByJson)
 	importGroup.POST("/xlsx", mw.CheckAdminOrNil, admin.ImportUserByXlsx)
 	importGroup.GET("/xlsx", admin.BatchImportTemplate)
 
-	defaultRouter := router.Group("/default", mw.CheckAdmin)
+	defaultRouter := router.Group("/default", mw.CheckAdmin, mw.CheckPermission(constant.PermissionDefault))
 	defaultUserRouter := defaultRouter.Group("/user")
 	defaultUserRouter.POST("/add", admin.AddDefaultFriend)       // Add default friend at registration
 	defaultUserRouter.POST("/del", admin.DelDefaultFriend)       // Delete default friend at registration
@@ -175,13 +242,13 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	defaultGroupRouter.POST("/find", admin.FindDefaultGroup)     // Get default group list at registration
 	defaultGroupRouter.POST("/search", admin.SearchDefaultGroup) // Search default group list at registration
 
-	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin)
+	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin, mw.CheckPermission(constant.PermissionInvitationCode))
 	invitationCodeRouter.POST("/add", admin.AddInvitationCode)       // Add invitation code
 	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)       // Generate invitation code
 	invitationCodeRouter.POST("/del", admin.DelInvitationCode)       // Delete invitation code
 	invitationCodeRouter.POST("/search", admin.SearchInvitationCode) // Search invitation code
 
-	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin)
+	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin, mw.CheckPermission(constant.PermissionForbidden))
 	ipForbiddenRouter := forbiddenRouter.Group("/ip")
 	ipForbiddenRouter.POST("/add", admin.AddIPForbidden)       // Add forbidden IP for registration/login
 	ipForbiddenRouter.POST("/del", admin.DelIPForbidden)       // Delete forbidden IP for registration/login
@@ -191,42 +258,61 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	userForbiddenRouter.POST("/del", admin.DelUserIPLimitLogin)       // Delete user limit on specific IP for login
 	userForbiddenRouter.POST("/search", admin.SearchUserIPLimitLogin) // Search limit for user login on specific IP
 
-	appletRouterGroup := router.Group("/applet", mw.CheckAdmin)
+	appletRouterGroup := router.Group("/applet", mw.CheckAdmin, mw.CheckPermission(constant.PermissionApplet))
 	appletRouterGroup.POST("/add", admin.AddApplet)       // Add applet
 	appletRouterGroup.POST("/del", admin.DelApplet)       // Delete applet
 	appletRouterGroup.POST("/update", admin.UpdateApplet) // Modify applet
 	appletRouterGroup.POST("/search", admin.SearchApplet) // Search applet
 
-	blockRouter := router.Group("/block", mw.CheckAdmin)
+	blockRouter := router.Group("/block", mw.CheckAdmin, mw.CheckPermission(constant.PermissionBlock))
 	blockRouter.POST("/add", admin.BlockUser)          // Block user
 	blockRouter.POST("/del", admin.UnblockUser)        // Unblock user
 	blockRouter.POST("/search", admin.SearchBlockUser) // Search blocked users
 
-	userRouter := router.Group("/user", mw.CheckAdmin)
-	userRouter.POST("/password/reset", admin.ResetUserPassword) // Reset user password
-	userRouter.POST("/add", org.RegisterUser)                   // 添加新用户
-
-	initGroup := router.Group("/client_config", mw.CheckAdmin)
+	userRouter := router.Group("/user", mw.CheckAdmin, mw.CheckPermission(constant.PermissionUser))
+	userRouter.POST("/password/reset", admin.ResetUserPassword)           // Reset user password, mustChange asks for a new one at the next login
+	userRouter.POST("/password/must_change", admin.SetMustChangePassword) // Ask users to change the password at the next login
+	userRouter.POST("/2fa/reset", admin.ResetUserTwoFactor)               // Reset user two-factor authentication
+	userRouter.POST("/login/unlock", admin.UnlockUserLogin)               // Unlock users or ips locked after wrong passwords
+	userRouter.POST("/export/start", admin.StartUserDataExport)           // Start exporting the personal data of a user
+	userRouter.POST("/export/get", admin.GetUserDataExport)               // Status of an export with its download url
+	userRouter.POST("/login/search", admin.SearchUserLoginRecord)         // Search login history of every user
+	userRouter.POST("/session/list", admin.GetUserSessions)               // Sessions of a user
+	userRouter.POST("/session/revoke", admin.RevokeUserSessions)          // Revoke sessions of a user, others revokes all of them
+	userRouter.POST("/add", org.RegisterUser)                             // 添加新用户
+
+	initGroup := router.Group("/client_config", mw.CheckAdmin, mw.CheckPermission(constant.PermissionClientConfig))
 	initGroup.POST("/get", admin.GetClientConfig) // Get client initialization configuration
 	initGroup.POST("/set", admin.SetClientConfig) // Set client initialization configuration
 	initGroup.POST("/del", admin.DelClientConfig) // Delete client initialization configuration
 
-	statistic := router.Group("/statistic", mw.CheckAdmin)
+	mailTemplate := router.Group("/mail_template", mw.CheckAdmin, mw.CheckPermission(constant.PermissionClientConfig))
+	mailTemplate.POST("/get", admin.GetMailTemplates) // Built-in and overridden mail templates
+	mailTemplate.POST("/set", admin.SetMailTemplate)  // Override the mail template of a purpose and locale
+	mailTemplate.POST("/del", admin.DelMailTemplate)  // Go back to the built-in mail template
+
+	statistic := router.Group("/statistic", mw.CheckAdmin, mw.CheckPermission(constant.PermissionStatistic))
 	statistic.POST("/new_user_count", admin.NewUserCount)
 	statistic.POST("/login_user_count", admin.LoginUserCount)
 
-	rtc := router.Group("/rtc", mw.CheckAdmin)
+	rtc := router.Group("/rtc", mw.CheckAdmin, mw.CheckPermission(constant.PermissionRtc))
 	rtc.POST("/get_signal_invitation_records", admin.GetSignalInvitationRecords)
 	rtc.POST("/delete_signal_records", admin.DeleteSignalRecords)
 	rtc.POST("/get_meeting_records", admin.GetMeetingRecords)
 	rtc.POST("/delete_meeting_records", admin.DeleteMeetingRecords)
 
-	logs := router.Group("/logs", mw.CheckAdmin)
+	audit := router.Group("/audit", mw.CheckAdmin, mw.CheckPermission(constant.PermissionAudit))
+	audit.POST("/search", admin.SearchAuditLog) // Search audit log of admin operations
+	audit.POST("/export", admin.ExportAuditLog) // Export audit log as csv
+	audit.POST("/verify", admin.VerifyAuditLog) // Verify the hash chain of the audit log
+
+	logs := router.Group("/logs", mw.CheckAdmin, mw.CheckPermission(constant.PermissionLogs))
 	logs.POST("/search", admin.SearchLogs)
 
-	organizationGroup := router.Group("/organization", mw.CheckAdmin)
+	organizationGroup := router.Group("/organization", mw.CheckAdmin, mw.CheckPermission(constant.PermissionOrganization))
 	router.GET("/organization/import/template", org.BatchImportTemplate) // 批量导入模板
 	organizationGroup.POST("/import", org.BatchImport)                   // 批量导入
+	organizationGroup.POST("/ldap/sync", ldapSync.Sync)                  // 同步LDAP目录
 	//部门  增删改查
 	organizationGroup.POST("/department/add", org.CreateDepartment)          // 创建部门
 	organizationGroup.POST("/department/update", org.UpdateDepartment)       // 修改部门
@@ -251,4 +337,20 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	organizationGroup.POST("/set", org.SetOrganization) // 设置公司信息
 
 	logs.POST("/delete", admin.DeleteLogs)
+
+	scimAPI := NewSCIM(org)
+	scimGroup := router.Group("/scim/v2", scimAPI.CheckToken)
+	scimGroup.GET("/ServiceProviderConfig", scimAPI.ServiceProviderConfig)
+	scimGroup.GET("/Users", scimAPI.GetUsers)            // 查询用户
+	scimGroup.POST("/Users", scimAPI.CreateUser)         // 创建用户
+	scimGroup.GET("/Users/:id", scimAPI.GetUser)         // 获取用户
+	scimGroup.PUT("/Users/:id", scimAPI.ReplaceUser)     // 替换用户
+	scimGroup.PATCH("/Users/:id", scimAPI.PatchUser)     // 修改用户
+	scimGroup.DELETE("/Users/:id", scimAPI.DeleteUser)   // 停用用户
+	scimGroup.GET("/Groups", scimAPI.GetGroups)          // 查询部门
+	scimGroup.POST("/Groups", scimAPI.CreateGroup)       // 创建部门
+	scimGroup.GET("/Groups/:id", scimAPI.GetGroup)       // 获取部门
+	scimGroup.PUT("/Groups/:id", scimAPI.ReplaceGroup)   // 替换部门
+	scimGroup.PATCH("/Groups/:id", scimAPI.PatchGroup)   // 修改部门及成员
+	scimGroup.DELETE("/Groups/:id", scimAPI.DeleteGroup) // 删除部门
 }
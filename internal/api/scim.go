@@ -0,0 +1,900 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/protocol/wrapperspb"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/gin-gonic/gin"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/scim"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+	"github.com/OpenIMSDK/chat/pkg/proto/common"
+	"github.com/OpenIMSDK/chat/pkg/proto/organization"
+)
+
+// scimProvider is the external identity provider of the scim externalId.
+const scimProvider = "scim"
+
+const (
+	scimDefaultCount = 100
+	scimMaxCount     = 1000
+)
+
+func NewSCIM(org *Org) *SCIM {
+	return &SCIM{org: org}
+}
+
+// SCIM serves users as scim Users and departments as scim Groups.
+type SCIM struct {
+	org *Org
+}
+
+// SCIMOperationID generates the operationID of scim requests, identity providers do not send the header.
+func SCIMOperationID(c *gin.Context) {
+	if strings.HasPrefix(c.Request.URL.Path, "/scim/") && c.GetHeader(constant.RpcOperationID) == "" {
+		c.Request.Header.Set(constant.RpcOperationID, "scim_"+strconv.FormatInt(time.Now().UnixNano(), 10))
+	}
+}
+
+// CheckToken authenticates the identity provider by the bearer token and acts as the default admin.
+func (s *SCIM) CheckToken(c *gin.Context) {
+	auth := c.GetHeader("Authorization")
+	token := strings.TrimPrefix(auth, "Bearer ")
+	if config.Config.SCIM.Token == "" || token == auth || subtle.ConstantTimeCompare([]byte(token), []byte(config.Config.SCIM.Token)) != 1 {
+		c.Abort()
+		s.write(c, http.StatusUnauthorized, scim.NewError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+	SetToken(c, mctx.GetOpUserID(mctx.WithAdminUser(c)), constant.AdminUser)
+}
+
+func (s *SCIM) write(c *gin.Context, status int, resp any) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+		return
+	}
+	c.Data(status, scim.ContentType, data)
+}
+
+func (s *SCIM) fail(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	var scimType string
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		switch codeErr.Code() {
+		case errs.ArgsError:
+			status, scimType = http.StatusBadRequest, "invalidValue"
+		case errs.NoPermissionError:
+			status = http.StatusForbidden
+		case errs.RecordNotFoundError, eerrs.ErrAccountNotFound.Code():
+			status = http.StatusNotFound
+		case errs.DuplicateKeyError, eerrs.ErrAccountAlreadyRegister.Code(), eerrs.ErrEmailAlreadyRegister.Code(), eerrs.ErrPhoneAlreadyRegister.Code():
+			status, scimType = http.StatusConflict, "uniqueness"
+		}
+	}
+	if status == http.StatusInternalServerError {
+		log.ZError(c, "scim request failed", err, "path", c.Request.URL.Path)
+	}
+	s.write(c, status, scim.NewError(status, scimType, err.Error()))
+}
+
+func (s *SCIM) bind(c *gin.Context, v any) error {
+	if err := json.NewDecoder(c.Request.Body).Decode(v); err != nil {
+		return errs.ErrArgs.Wrap(err.Error())
+	}
+	return nil
+}
+
+func (s *SCIM) ServiceProviderConfig(c *gin.Context) {
+	s.write(c, http.StatusOK, map[string]any{
+		"schemas":        []string{scim.ServiceConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxCount},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with the token configured in scim.token",
+		}},
+	})
+}
+
+// scimQuery is the filter and the one-based page of a list request.
+type scimQuery struct {
+	filter     scim.Filter
+	startIndex int
+	count      int
+}
+
+func (s *SCIM) parseQuery(c *gin.Context) (*scimQuery, error) {
+	q := &scimQuery{startIndex: 1, count: scimDefaultCount}
+	if val := c.Query("filter"); val != "" {
+		filter, err := scim.ParseFilter(val)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap(err.Error())
+		}
+		q.filter = filter
+	}
+	if val := c.Query("startIndex"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap("invalid startIndex")
+		}
+		if n > 1 {
+			q.startIndex = n
+		}
+	}
+	if val := c.Query("count"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return nil, errs.ErrArgs.Wrap("invalid count")
+		}
+		q.count = n
+	}
+	if q.count > scimMaxCount {
+		q.count = scimMaxCount
+	}
+	return q, nil
+}
+
+// eq returns the value of an attr eq "value" filter.
+func (q *scimQuery) eq(attr string) (string, bool) {
+	cmp, ok := q.filter.(scim.CompareFilter)
+	if !ok || cmp.Op != "eq" || !strings.EqualFold(cmp.Path, attr) {
+		return "", false
+	}
+	val, ok := cmp.Value.(string)
+	return val, ok
+}
+
+// page returns the bounds of the page within total resources.
+func (q *scimQuery) page(total int) (int, int) {
+	start := q.startIndex - 1
+	if start > total {
+		start = total
+	}
+	end := start + q.count
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func (q *scimQuery) resp(total int, resources []any) *scim.ListResponse {
+	if resources == nil {
+		resources = []any{}
+	}
+	return &scim.ListResponse{
+		Schemas:      []string{scim.ListResponseSchema},
+		TotalResults: total,
+		StartIndex:   q.startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func (q *scimQuery) match(resource any) (bool, error) {
+	if q.filter == nil {
+		return true, nil
+	}
+	m, err := scim.ToMap(resource)
+	if err != nil {
+		return false, err
+	}
+	return q.filter.Match(m), nil
+}
+
+func (s *SCIM) GetUsers(c *gin.Context) {
+	q, err := s.parseQuery(c)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	infos, err := s.searchUsers(c, q)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	if q.filter == nil {
+		start, end := q.page(len(infos))
+		users, err := s.toUsers(c, infos[start:end])
+		if err != nil {
+			s.fail(c, err)
+			return
+		}
+		s.write(c, http.StatusOK, q.resp(len(infos), utils.Slice(users, func(u *scim.User) any { return u })))
+		return
+	}
+	users, err := s.toUsers(c, infos)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	var matched []any
+	for _, user := range users {
+		ok, err := q.match(user)
+		if err != nil {
+			s.fail(c, err)
+			return
+		}
+		if ok {
+			matched = append(matched, user)
+		}
+	}
+	start, end := q.page(len(matched))
+	s.write(c, http.StatusOK, q.resp(len(matched), matched[start:end]))
+}
+
+// searchUsers narrows the users by userName or externalId equality, or returns all of them.
+func (s *SCIM) searchUsers(ctx context.Context, q *scimQuery) ([]*common.UserFullInfo, error) {
+	if account, ok := q.eq("userName"); ok {
+		resp, err := s.org.chatClient.FindAccountUser(ctx, &chat.FindAccountUserReq{Accounts: []string{account}})
+		if err != nil {
+			return nil, err
+		}
+		return s.findUsers(ctx, utils.Values(resp.AccountUserMap))
+	}
+	if externalID, ok := q.eq("externalId"); ok {
+		resp, err := s.org.chatClient.FindExternalIdentity(ctx, &chat.FindExternalIdentityReq{Provider: scimProvider})
+		if err != nil {
+			return nil, err
+		}
+		var userIDs []string
+		for _, identity := range resp.Identities {
+			if identity.Subject == externalID {
+				userIDs = append(userIDs, identity.UserID)
+			}
+		}
+		return s.findUsers(ctx, userIDs)
+	}
+	const showNumber = 500
+	var infos []*common.UserFullInfo
+	for pageNumber := int32(1); ; pageNumber++ {
+		resp, err := s.org.chatClient.SearchUserFullInfo(ctx, &chat.SearchUserFullInfoReq{
+			Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber},
+		})
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, resp.Users...)
+		if len(resp.Users) < showNumber {
+			return infos, nil
+		}
+	}
+}
+
+func (s *SCIM) findUsers(ctx context.Context, userIDs []string) ([]*common.UserFullInfo, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	resp, err := s.org.chatClient.FindUserFullInfo(ctx, &chat.FindUserFullInfoReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// toUsers converts the users with their block state, departments and scim externalId.
+func (s *SCIM) toUsers(ctx context.Context, infos []*common.UserFullInfo) ([]*scim.User, error) {
+	if len(infos) == 0 {
+		return nil, nil
+	}
+	userIDs := utils.Slice(infos, func(info *common.UserFullInfo) string { return info.UserID })
+	blockResp, err := s.org.adminClient.FindUserBlockInfo(ctx, &admin.FindUserBlockInfoReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	blocked := make(map[string]struct{})
+	for _, info := range blockResp.Blocks {
+		blocked[info.UserID] = struct{}{}
+	}
+	memberResp, err := s.org.organizationClient.GetUserInDepartment(ctx, &organization.GetUserInDepartmentReq{UserIDs: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[string][]*organization.MemberDepartment)
+	for _, user := range memberResp.Users {
+		if user.User != nil {
+			members[user.User.UserID] = user.Members
+		}
+	}
+	identityResp, err := s.org.chatClient.FindExternalIdentity(ctx, &chat.FindExternalIdentityReq{Provider: scimProvider})
+	if err != nil {
+		return nil, err
+	}
+	externalIDs := make(map[string]string)
+	for _, identity := range identityResp.Identities {
+		externalIDs[identity.UserID] = identity.Subject
+	}
+	users := make([]*scim.User, 0, len(infos))
+	for _, info := range infos {
+		_, ok := blocked[info.UserID]
+		user := &scim.User{
+			Schemas:     []string{scim.UserSchema},
+			ID:          info.UserID,
+			ExternalID:  externalIDs[info.UserID],
+			UserName:    info.Account,
+			DisplayName: info.Nickname,
+			NickName:    info.Nickname,
+			Title:       info.Station,
+			Active:      scim.Bool(!ok),
+			Meta:        &scim.Meta{ResourceType: "User"},
+		}
+		if info.EnglishName != "" {
+			user.Name = &scim.Name{Formatted: info.EnglishName}
+		}
+		if info.Email != "" {
+			user.Emails = []scim.MultiValue{{Value: info.Email, Type: "work", Primary: true}}
+		}
+		if info.Telephone != "" {
+			user.PhoneNumbers = []scim.MultiValue{{Value: info.Telephone, Type: "work", Primary: true}}
+		}
+		for _, member := range members[info.UserID] {
+			if member.TerminationTime != 0 {
+				continue
+			}
+			group := scim.MultiValue{Value: member.DepartmentID}
+			if member.Department != nil {
+				group.Display = member.Department.Name
+			}
+			user.Groups = append(user.Groups, group)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func (s *SCIM) takeUser(ctx context.Context, userID string) (*scim.User, error) {
+	infos, err := s.findUsers(ctx, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(infos) == 0 {
+		return nil, errs.ErrRecordNotFound.Wrap("user not found")
+	}
+	users, err := s.toUsers(ctx, infos)
+	if err != nil {
+		return nil, err
+	}
+	return users[0], nil
+}
+
+func (s *SCIM) GetUser(c *gin.Context) {
+	user, err := s.takeUser(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusOK, user)
+}
+
+// scimNickname picks the nickname from the names of the user.
+func scimNickname(user *scim.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if user.NickName != "" {
+		return user.NickName
+	}
+	if user.Name != nil {
+		if user.Name.Formatted != "" {
+			return user.Name.Formatted
+		}
+		if name := strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return user.UserName
+}
+
+func scimEnglishName(user *scim.User) string {
+	if user.Name == nil {
+		return ""
+	}
+	return user.Name.Formatted
+}
+
+func (s *SCIM) CreateUser(c *gin.Context) {
+	user := scim.User{Active: true}
+	if err := s.bind(c, &user); err != nil {
+		s.fail(c, err)
+		return
+	}
+	if user.UserName == "" {
+		s.fail(c, errs.ErrArgs.Wrap("userName is empty"))
+		return
+	}
+	ip, err := s.org.getClientIP(c)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	userID, err := s.org.registerUser(c, &registerUserReq{User: chat.RegisterUserInfo{
+		Account:     user.UserName,
+		Nickname:    scimNickname(&user),
+		Email:       scim.PrimaryValue(user.Emails),
+		Telephone:   scim.PrimaryValue(user.PhoneNumbers),
+		Station:     user.Title,
+		EnglishName: scimEnglishName(&user),
+	}}, ip)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	if user.ExternalID != "" {
+		identity := &chat.ExternalIdentity{Provider: scimProvider, Subject: user.ExternalID, UserID: userID, Email: scim.PrimaryValue(user.Emails)}
+		if _, err := s.org.chatClient.AddExternalIdentity(c, &chat.AddExternalIdentityReq{Identity: identity}); err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	if !user.Active {
+		if err := s.setActive(c, userID, false); err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	res, err := s.takeUser(c, userID)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusCreated, res)
+}
+
+func (s *SCIM) ReplaceUser(c *gin.Context) {
+	cur, err := s.takeUser(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	user := scim.User{Active: true}
+	if err := s.bind(c, &user); err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.saveUser(c, cur, &user)
+}
+
+func (s *SCIM) PatchUser(c *gin.Context) {
+	cur, err := s.takeUser(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	var patch scim.PatchOp
+	if err := s.bind(c, &patch); err != nil {
+		s.fail(c, err)
+		return
+	}
+	m, err := scim.ToMap(cur)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	if err := scim.ApplyPatch(m, patch.Operations); err != nil {
+		s.fail(c, errs.ErrArgs.Wrap(err.Error()))
+		return
+	}
+	var user scim.User
+	if err := scim.FromMap(m, &user); err != nil {
+		s.fail(c, errs.ErrArgs.Wrap(err.Error()))
+		return
+	}
+	s.saveUser(c, cur, &user)
+}
+
+// saveUser writes the changes from cur to user, groups are read only and managed through the Groups.
+func (s *SCIM) saveUser(c *gin.Context, cur *scim.User, user *scim.User) {
+	req := &chat.UpdateUserInfoReq{UserID: cur.ID}
+	var changed bool
+	set := func(field **wrapperspb.StringValue, cur string, val string) {
+		if cur != val {
+			*field = wrapperspb.String(val)
+			changed = true
+		}
+	}
+	if user.UserName != "" {
+		set(&req.Account, cur.UserName, user.UserName)
+	}
+	set(&req.Nickname, cur.DisplayName, scimNickname(user))
+	set(&req.Email, scim.PrimaryValue(cur.Emails), scim.PrimaryValue(user.Emails))
+	set(&req.Telephone, scim.PrimaryValue(cur.PhoneNumbers), scim.PrimaryValue(user.PhoneNumbers))
+	set(&req.Station, cur.Title, user.Title)
+	set(&req.EnglishName, scimEnglishName(cur), scimEnglishName(user))
+	if changed {
+		if _, err := s.org.chatClient.UpdateUserInfo(c, req); err != nil {
+			s.fail(c, err)
+			return
+		}
+		if req.Nickname != nil {
+			imToken, err := s.org.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+			if err != nil {
+				s.fail(c, err)
+				return
+			}
+			if err := s.org.imApiCaller.UpdateUserInfo(mctx.WithApiToken(c, imToken), cur.ID, req.Nickname.Value, ""); err != nil {
+				s.fail(c, err)
+				return
+			}
+		}
+	}
+	if cur.ExternalID == "" && user.ExternalID != "" {
+		identity := &chat.ExternalIdentity{Provider: scimProvider, Subject: user.ExternalID, UserID: cur.ID, Email: scim.PrimaryValue(user.Emails)}
+		if _, err := s.org.chatClient.AddExternalIdentity(c, &chat.AddExternalIdentityReq{Identity: identity}); err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	if cur.Active != user.Active {
+		if err := s.setActive(c, cur.ID, bool(user.Active)); err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	res, err := s.takeUser(c, cur.ID)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusOK, res)
+}
+
+// DeleteUser deactivates the user, the account is kept for the messages it sent.
+func (s *SCIM) DeleteUser(c *gin.Context) {
+	user, err := s.takeUser(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	if user.Active {
+		if err := s.setActive(c, user.ID, false); err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// setActive blocks a deactivated user and terminates it in its departments, activating reverses both.
+func (s *SCIM) setActive(ctx context.Context, userID string, active bool) error {
+	var terminationTime int64
+	if active {
+		if _, err := s.org.adminClient.UnblockUser(ctx, &admin.UnblockUserReq{UserIDs: []string{userID}}); err != nil {
+			return err
+		}
+	} else {
+		terminationTime = time.Now().UnixMilli()
+		if _, err := s.org.adminClient.BlockUser(ctx, &admin.BlockUserReq{UserID: userID, Reason: "scim deactivated"}); err != nil {
+			return err
+		}
+		imToken, err := s.org.imApiCaller.ImAdminTokenWithDefaultAdmin(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.org.imApiCaller.ForceOffLine(mctx.WithApiToken(ctx, imToken), userID); err != nil {
+			return err
+		}
+	}
+	resp, err := s.org.organizationClient.GetUserInDepartment(ctx, &organization.GetUserInDepartmentReq{UserIDs: []string{userID}})
+	if err != nil {
+		return err
+	}
+	for _, user := range resp.Users {
+		for _, member := range user.Members {
+			if (member.TerminationTime == 0) == active {
+				continue
+			}
+			_, err := s.org.organizationClient.UpdateUserInDepartment(ctx, &organization.UpdateUserInDepartmentReq{
+				UserID:          userID,
+				DepartmentID:    member.DepartmentID,
+				TerminationTime: wrapperspb.Int64(terminationTime),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scimGroup is a department with all its members, terminated ones are not listed in the group.
+type scimGroup struct {
+	group   *scim.Group
+	members map[string]*organization.DepartmentMember
+}
+
+func (s *SCIM) loadGroup(ctx context.Context, department *organization.DepartmentNum, withMembers bool) (*scimGroup, error) {
+	res := &scimGroup{
+		group: &scim.Group{
+			Schemas:     []string{scim.GroupSchema},
+			ID:          department.DepartmentID,
+			DisplayName: department.Name,
+			Meta:        &scim.Meta{ResourceType: "Group"},
+		},
+		members: make(map[string]*organization.DepartmentMember),
+	}
+	if department.CreateTime > 0 {
+		res.group.Meta.Created = time.UnixMilli(department.CreateTime).UTC().Format(time.RFC3339)
+	}
+	if !withMembers {
+		return res, nil
+	}
+	resp, err := s.org.organizationClient.GetSubDepartment(ctx, &organization.GetSubDepartmentReq{DepartmentID: department.DepartmentID})
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range resp.Members {
+		if member.Member == nil {
+			continue
+		}
+		res.members[member.Member.UserID] = member.Member
+		if member.Member.TerminationTime != 0 {
+			continue
+		}
+		value := scim.MultiValue{Value: member.Member.UserID}
+		if member.User != nil {
+			value.Display = member.User.Nickname
+		}
+		res.group.Members = append(res.group.Members, value)
+	}
+	return res, nil
+}
+
+func (s *SCIM) takeGroup(ctx context.Context, departmentID string) (*scimGroup, error) {
+	resp, err := s.org.organizationClient.GetDepartment(ctx, &organization.GetDepartmentReq{DepartmentIDs: []string{departmentID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Departments) == 0 || departmentID == constant.UngroupedID {
+		return nil, errs.ErrRecordNotFound.Wrap("group not found")
+	}
+	department := resp.Departments[0]
+	return s.loadGroup(ctx, &organization.DepartmentNum{
+		DepartmentID:       department.DepartmentID,
+		Name:               department.Name,
+		ParentDepartmentID: department.ParentDepartmentID,
+		CreateTime:         department.CreateTime,
+	}, true)
+}
+
+func (s *SCIM) GetGroups(c *gin.Context) {
+	q, err := s.parseQuery(c)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	resp, err := s.org.organizationClient.GetOrganizationDepartment(c, &organization.GetOrganizationDepartmentReq{})
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	var departments []*organization.DepartmentNum
+	var walk func(infos []*organization.DepartmentInfo)
+	walk = func(infos []*organization.DepartmentInfo) {
+		for _, info := range infos {
+			if info.Department != nil && info.Department.DepartmentID != constant.UngroupedID {
+				departments = append(departments, info.Department)
+			}
+			walk(info.Subdepartments)
+		}
+	}
+	walk(resp.Departments)
+	withMembers := !strings.Contains(c.Query("excludedAttributes"), "members")
+	var matched []any
+	for _, department := range departments {
+		group, err := s.loadGroup(c, department, withMembers)
+		if err != nil {
+			s.fail(c, err)
+			return
+		}
+		ok, err := q.match(group.group)
+		if err != nil {
+			s.fail(c, err)
+			return
+		}
+		if ok {
+			matched = append(matched, group.group)
+		}
+	}
+	start, end := q.page(len(matched))
+	s.write(c, http.StatusOK, q.resp(len(matched), matched[start:end]))
+}
+
+func (s *SCIM) GetGroup(c *gin.Context) {
+	group, err := s.takeGroup(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusOK, group.group)
+}
+
+func (s *SCIM) CreateGroup(c *gin.Context) {
+	var group scim.Group
+	if err := s.bind(c, &group); err != nil {
+		s.fail(c, err)
+		return
+	}
+	if group.DisplayName == "" {
+		s.fail(c, errs.ErrArgs.Wrap("displayName is empty"))
+		return
+	}
+	resp, err := s.org.organizationClient.CreateDepartment(c, &organization.CreateDepartmentReq{Name: group.DisplayName})
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	cur := &scimGroup{
+		group:   &scim.Group{ID: resp.DepartmentID, DisplayName: group.DisplayName},
+		members: make(map[string]*organization.DepartmentMember),
+	}
+	if err := s.updateMembers(c, cur, &group); err != nil {
+		s.fail(c, err)
+		return
+	}
+	res, err := s.takeGroup(c, resp.DepartmentID)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusCreated, res.group)
+}
+
+func (s *SCIM) ReplaceGroup(c *gin.Context) {
+	cur, err := s.takeGroup(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	var group scim.Group
+	if err := s.bind(c, &group); err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.saveGroup(c, cur, &group)
+}
+
+func (s *SCIM) PatchGroup(c *gin.Context) {
+	cur, err := s.takeGroup(c, c.Param("id"))
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	var patch scim.PatchOp
+	if err := s.bind(c, &patch); err != nil {
+		s.fail(c, err)
+		return
+	}
+	m, err := scim.ToMap(cur.group)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	if err := scim.ApplyPatch(m, patch.Operations); err != nil {
+		s.fail(c, errs.ErrArgs.Wrap(err.Error()))
+		return
+	}
+	var group scim.Group
+	if err := scim.FromMap(m, &group); err != nil {
+		s.fail(c, errs.ErrArgs.Wrap(err.Error()))
+		return
+	}
+	s.saveGroup(c, cur, &group)
+}
+
+func (s *SCIM) saveGroup(c *gin.Context, cur *scimGroup, group *scim.Group) {
+	if group.DisplayName != "" && group.DisplayName != cur.group.DisplayName {
+		_, err := s.org.organizationClient.UpdateDepartment(c, &organization.UpdateDepartmentReq{
+			DepartmentID: cur.group.ID,
+			Name:         wrapperspb.String(group.DisplayName),
+		})
+		if err != nil {
+			s.fail(c, err)
+			return
+		}
+	}
+	if err := s.updateMembers(c, cur, group); err != nil {
+		s.fail(c, err)
+		return
+	}
+	res, err := s.takeGroup(c, cur.group.ID)
+	if err != nil {
+		s.fail(c, err)
+		return
+	}
+	s.write(c, http.StatusOK, res.group)
+}
+
+// updateMembers adds the new members to the department and removes the ones no longer listed.
+func (s *SCIM) updateMembers(ctx context.Context, cur *scimGroup, group *scim.Group) error {
+	departmentID := cur.group.ID
+	listed := make(map[string]struct{})
+	for _, member := range cur.group.Members {
+		listed[member.Value] = struct{}{}
+	}
+	want := make(map[string]struct{})
+	for _, member := range group.Members {
+		if member.Value == "" {
+			continue
+		}
+		want[member.Value] = struct{}{}
+		if _, ok := listed[member.Value]; ok {
+			continue
+		}
+		if _, ok := cur.members[member.Value]; ok {
+			_, err := s.org.organizationClient.UpdateUserInDepartment(ctx, &organization.UpdateUserInDepartmentReq{
+				UserID:          member.Value,
+				DepartmentID:    departmentID,
+				EntryTime:       wrapperspb.Int64(time.Now().UnixMilli()),
+				TerminationTime: wrapperspb.Int64(0),
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		_, err := s.org.organizationClient.CreateDepartmentMember(ctx, &organization.CreateDepartmentMemberReq{
+			UserID:       member.Value,
+			DepartmentID: departmentID,
+			EntryTime:    time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for userID := range listed {
+		if _, ok := want[userID]; ok {
+			continue
+		}
+		_, err := s.org.organizationClient.DeleteUserInDepartment(ctx, &organization.DeleteUserInDepartmentReq{UserID: userID, DepartmentID: departmentID})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SCIM) DeleteGroup(c *gin.Context) {
+	if _, err := s.takeGroup(c, c.Param("id")); err != nil {
+		s.fail(c, err)
+		return
+	}
+	if _, err := s.org.organizationClient.DeleteDepartment(c, &organization.DeleteDepartmentReq{DepartmentIDs: []string{c.Param("id")}}); err != nil {
+		s.fail(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
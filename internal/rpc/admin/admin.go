@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	constant2 "github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
@@ -25,6 +26,7 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 	"google.golang.org/grpc"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
@@ -33,7 +35,10 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/password"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/rpclient/chat"
@@ -54,6 +59,11 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		admin2.RegisterAddFriend{},
 		admin2.RegisterAddGroup{},
 		admin2.ClientConfig{},
+		admin2.AdminLoginIP{},
+		admin2.SigningKey{},
+		admin2.ApiKey{},
+		admin2.Role{},
+		admin2.AdminRole{},
 	}
 	if err := db.AutoMigrate(tables...); err != nil {
 		return errs.Wrap(err)
@@ -72,15 +82,30 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		panic(errs.Wrap(err, "CreateRpcRootNodes error"))
 	}
 
-	admin.RegisterAdminServer(server, &adminServer{Database: adminDatabase,
-		Chat: chat.NewChatClient(discov),
-	})
+	srv := &adminServer{Database: adminDatabase,
+		Audit: database.NewAuditDatabase(db),
+		Chat:  chat.NewChatClient(discov),
+		Lock:  cache.NewSyncLockInterface(rdb),
+	}
+	if err := srv.loadSigningKeys(context.Background()); err != nil {
+		return err
+	}
+	go srv.signingKeyLoop()
+	if err := srv.loadIPForbidden(context.Background()); err != nil {
+		return err
+	}
+	go srv.ipForbiddenLoop()
+	admin.RegisterAdminServer(server, srv)
 	return nil
 }
 
 type adminServer struct {
 	Database database.AdminDatabaseInterface
+	Audit    database.AuditDatabaseInterface
 	Chat     *chat.ChatClient
+	Lock     cache.SyncLockInterface
+
+	ipForbidden atomic.Pointer[iprule.Trie[*admin2.IPForbidden]]
 }
 
 func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoReq) (*admin.GetAdminInfoResp, error) {
@@ -92,14 +117,23 @@ func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoR
 	if err != nil {
 		return nil, err
 	}
+	permissions, all, err := o.getPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		permissions = constant.Permissions
+	}
 	return &admin.GetAdminInfoResp{
 		Account:    a.Account,
-		Password:   a.Password,
 		FaceURL:    a.FaceURL,
 		Nickname:   a.Nickname,
 		UserID:     a.UserID,
 		Level:      a.Level,
 		CreateTime: a.CreateTime.UnixMilli(),
+
+		TwoFactorEnabled: a.TwoFactorEnabled,
+		Permissions:      permissions,
 	}, nil
 }
 
@@ -109,11 +143,14 @@ func (o *adminServer) ChangeAdminPassword(ctx context.Context, req *admin.Change
 		return nil, err
 	}
 
-	if user.Password != req.CurrentPassword {
+	if match, _ := password.Verify(user.Password, req.CurrentPassword); !match {
 		return nil, errs.ErrInternalServer.Wrap("password error")
 	}
-
-	if err := o.Database.ChangePassword(ctx, req.UserID, req.NewPassword); err != nil {
+	hashedPassword, err := password.Hash(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.ChangePassword(ctx, req.UserID, hashedPassword); err != nil {
 		return nil, err
 	}
 	return &admin.ChangeAdminPasswordResp{}, nil
@@ -129,9 +166,13 @@ func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAc
 		return nil, errs.ErrRegisteredAlready.Wrap("the account is registered")
 	}
 
+	hashedPassword, err := password.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
 	adm := &admin2.Admin{
 		Account:    req.Account,
-		Password:   req.Password,
+		Password:   hashedPassword,
 		FaceURL:    req.FaceURL,
 		Nickname:   req.Nickname,
 		UserID:     o.genUserID(),
@@ -227,27 +268,67 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 }
 
 func (o *adminServer) Login(ctx context.Context, req *admin.LoginReq) (*admin.LoginResp, error) {
+	if err := o.checkAdminLoginIP(ctx, req.Ip); err != nil {
+		return nil, err
+	}
+	if req.Ip != "" {
+		if err := loginlock.Check(ctx, o.Database, loginLockIP+req.Ip); err != nil {
+			return nil, err
+		}
+	}
 	a, err := o.Database.GetAdmin(ctx, req.Account)
 	if err != nil {
 		if dbutil.IsGormNotFound(err) {
+			if err := o.loginFailed(ctx, nil, req.Ip); err != nil {
+				return nil, err
+			}
 			return nil, eerrs.ErrAccountNotFound.Wrap()
 		}
 		return nil, err
 	}
-	if a.Password != req.Password {
+	if err := loginlock.Check(ctx, o.Database, loginLockAdmin+a.UserID); err != nil {
+		return nil, err
+	}
+	match, upgrade := password.Verify(a.Password, req.Password)
+	if !match {
+		if err := o.loginFailed(ctx, a, req.Ip); err != nil {
+			return nil, err
+		}
 		return nil, eerrs.ErrPassword.Wrap()
 	}
-	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
+	if upgrade {
+		if update, err := ToDBAdminUpdatePassword(req.Password); err != nil {
+			log.ZError(ctx, "hash admin password failed", err, "account", a.Account)
+		} else if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
+			log.ZError(ctx, "upgrade admin password hash failed", err, "account", a.Account)
+		}
+	}
+	challenge, setup, err := o.twoFactorChallenge(ctx, a, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != "" {
+		return &admin.LoginResp{
+			AdminAccount:       a.Account,
+			TwoFactorChallenge: challenge,
+			TwoFactorSetup:     setup,
+		}, nil
+	}
+	if err := o.Database.DelLoginFail(ctx, loginLockAdmin+a.UserID); err != nil {
+		log.ZError(ctx, "reset admin login failures failed", err, "account", a.Account)
+	}
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser, Platform: constant2.AdminPlatformID, Ip: req.Ip})
 	if err != nil {
 		return nil, err
 	}
 	return &admin.LoginResp{
-		AdminUserID:  a.UserID,
-		AdminAccount: a.Account,
-		AdminToken:   adminToken.Token,
-		Nickname:     a.Nickname,
-		FaceURL:      a.FaceURL,
-		Level:        a.Level,
+		AdminUserID:       a.UserID,
+		AdminAccount:      a.Account,
+		AdminToken:        adminToken.Token,
+		AdminRefreshToken: adminToken.RefreshToken,
+		Nickname:          a.Nickname,
+		FaceURL:           a.FaceURL,
+		Level:             a.Level,
 	}, nil
 }
 
@@ -0,0 +1,124 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+// checkAdminLoginIP an empty allowlist does not restrict admin login.
+func (o *adminServer) checkAdminLoginIP(ctx context.Context, ip string) error {
+	count, err := o.Database.CountAdminLoginIP(ctx)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return nil
+	}
+	if ip == "" {
+		return eerrs.ErrForbidden.Wrap("admin login ip is empty")
+	}
+	if _, err := o.Database.TakeAdminLoginIP(ctx, ip); err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return eerrs.ErrForbidden.Wrap("admin login ip not allowed")
+		}
+		return err
+	}
+	return nil
+}
+
+// checkAdminRefresh applies the checks of the admin login to a token refresh, so a
+// refresh token does not outlive a removed account, a locked account or a changed ip allowlist.
+func (o *adminServer) checkAdminRefresh(ctx context.Context, userID string, ip string) error {
+	if err := o.checkAdminLoginIP(ctx, ip); err != nil {
+		return err
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, userID); err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return errs.ErrTokenKicked.Wrap("admin account not found")
+		}
+		return err
+	}
+	keys := []string{loginLockAdmin + userID}
+	if ip != "" {
+		keys = append(keys, loginLockIP+ip)
+	}
+	return loginlock.Check(ctx, o.Database, keys...)
+}
+
+func (o *adminServer) SearchAdminLoginIP(ctx context.Context, req *admin.SearchAdminLoginIPReq) (*admin.SearchAdminLoginIPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, ips, err := o.Database.SearchAdminLoginIP(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAdminLoginIPResp{Total: total, Ips: make([]*admin.AdminLoginIP, 0, len(ips))}
+	for _, ip := range ips {
+		resp.Ips = append(resp.Ips, &admin.AdminLoginIP{
+			Ip:         ip.IP,
+			Remark:     ip.Remark,
+			CreateTime: ip.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) AddAdminLoginIP(ctx context.Context, req *admin.AddAdminLoginIPReq) (*admin.AddAdminLoginIPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	ms := make([]*admin2.AdminLoginIP, 0, len(req.Ips))
+	for _, ip := range req.Ips {
+		if net.ParseIP(ip.Ip) == nil {
+			return nil, errs.ErrArgs.Wrap("invalid ip " + ip.Ip)
+		}
+		ms = append(ms, &admin2.AdminLoginIP{
+			IP:         ip.Ip,
+			Remark:     ip.Remark,
+			CreateTime: now,
+		})
+	}
+	if err := o.Database.AddAdminLoginIP(ctx, ms); err != nil {
+		return nil, err
+	}
+	return &admin.AddAdminLoginIPResp{}, nil
+}
+
+func (o *adminServer) DelAdminLoginIP(ctx context.Context, req *admin.DelAdminLoginIPReq) (*admin.DelAdminLoginIPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelAdminLoginIP(ctx, req.Ips); err != nil {
+		return nil, err
+	}
+	return &admin.DelAdminLoginIPResp{}, nil
+}
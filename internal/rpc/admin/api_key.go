@@ -0,0 +1,200 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+// apiKeyTouchInterval limits how often the last used time of a key is written.
+const apiKeyTouchInterval = time.Minute
+
+func hashApiKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// splitApiKey the api key is keyID.secret.
+func splitApiKey(apiKey string) (string, string, bool) {
+	keyID, secret, ok := strings.Cut(apiKey, ".")
+	return keyID, secret, ok && keyID != "" && secret != ""
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// ipAllowed an empty allowlist does not restrict the ip.
+func ipAllowed(allowIPs []string, ip string) bool {
+	if len(allowIPs) == 0 {
+		return true
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, allow := range allowIPs {
+		if _, ipNet, err := net.ParseCIDR(allow); err == nil {
+			if ipNet.Contains(addr) {
+				return true
+			}
+		} else if allowIP := net.ParseIP(allow); allowIP != nil && allowIP.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *adminServer) AddApiKey(ctx context.Context, req *admin.AddApiKeyReq) (*admin.AddApiKeyResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	for _, scope := range req.Scopes {
+		if !utils.Contain(scope, constant.ApiKeyScopes...) {
+			return nil, errs.ErrArgs.Wrap("unknown scope " + scope)
+		}
+	}
+	for _, ip := range req.AllowIPs {
+		if _, _, err := net.ParseCIDR(ip); err != nil && net.ParseIP(ip) == nil {
+			return nil, errs.ErrArgs.Wrap("invalid ip " + ip)
+		}
+	}
+	now := time.Now()
+	var expireTime time.Time
+	if req.ExpireTime > 0 {
+		expireTime = time.UnixMilli(req.ExpireTime)
+		if expireTime.Before(now) {
+			return nil, errs.ErrArgs.Wrap("expire time is in the past")
+		}
+	}
+	keyID, err := genRandomToken(8)
+	if err != nil {
+		return nil, err
+	}
+	keyID = "ak" + keyID
+	secret, err := genRandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	m := &admin2.ApiKey{
+		KeyID:         keyID,
+		Name:          req.Name,
+		SecretHash:    hashApiKeySecret(secret),
+		Scopes:        strings.Join(utils.Distinct(req.Scopes), ","),
+		AllowIPs:      strings.Join(utils.Distinct(req.AllowIPs), ","),
+		ExpireTime:    expireTime,
+		CreatorUserID: mcontext.GetOpUserID(ctx),
+		CreateTime:    now,
+	}
+	if err := o.Database.CreateApiKey(ctx, []*admin2.ApiKey{m}); err != nil {
+		return nil, err
+	}
+	return &admin.AddApiKeyResp{KeyID: keyID, ApiKey: keyID + "." + secret}, nil
+}
+
+func (o *adminServer) SearchApiKey(ctx context.Context, req *admin.SearchApiKeyReq) (*admin.SearchApiKeyResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, keys, err := o.Database.SearchApiKey(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchApiKeyResp{Total: total, Keys: make([]*admin.ApiKey, 0, len(keys))}
+	for _, key := range keys {
+		pb := &admin.ApiKey{
+			KeyID:         key.KeyID,
+			Name:          key.Name,
+			Scopes:        splitList(key.Scopes),
+			AllowIPs:      splitList(key.AllowIPs),
+			CreatorUserID: key.CreatorUserID,
+			CreateTime:    key.CreateTime.UnixMilli(),
+		}
+		if !key.ExpireTime.IsZero() {
+			pb.ExpireTime = key.ExpireTime.UnixMilli()
+		}
+		if !key.LastUsedTime.IsZero() {
+			pb.LastUsedTime = key.LastUsedTime.UnixMilli()
+		}
+		resp.Keys = append(resp.Keys, pb)
+	}
+	return resp, nil
+}
+
+func (o *adminServer) DelApiKey(ctx context.Context, req *admin.DelApiKeyReq) (*admin.DelApiKeyResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelApiKey(ctx, req.KeyIDs); err != nil {
+		return nil, err
+	}
+	return &admin.DelApiKeyResp{}, nil
+}
+
+// CheckApiKey verifies the secret, expiry, ip allowlist and scope of the key.
+func (o *adminServer) CheckApiKey(ctx context.Context, req *admin.CheckApiKeyReq) (*admin.CheckApiKeyResp, error) {
+	keyID, secret, ok := splitApiKey(req.ApiKey)
+	if !ok {
+		return nil, errs.ErrNoPermission.Wrap("invalid api key")
+	}
+	key, err := o.Database.TakeApiKey(ctx, keyID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil, errs.ErrNoPermission.Wrap("invalid api key")
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashApiKeySecret(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, errs.ErrNoPermission.Wrap("invalid api key")
+	}
+	now := time.Now()
+	if !key.ExpireTime.IsZero() && key.ExpireTime.Before(now) {
+		return nil, errs.ErrNoPermission.Wrap("api key expired")
+	}
+	if !ipAllowed(splitList(key.AllowIPs), req.Ip) {
+		return nil, errs.ErrNoPermission.Wrap("api key ip not allowed")
+	}
+	if !utils.Contain(req.Scope, splitList(key.Scopes)...) {
+		return nil, errs.ErrNoPermission.Wrap("api key lacks scope " + req.Scope)
+	}
+	if now.Sub(key.LastUsedTime) > apiKeyTouchInterval {
+		if err := o.Database.UpdateApiKey(ctx, keyID, map[string]any{"last_used_time": now}); err != nil {
+			log.ZError(ctx, "update api key last used time failed", err, "keyID", keyID)
+		}
+	}
+	return &admin.CheckApiKeyResp{KeyID: keyID}, nil
+}
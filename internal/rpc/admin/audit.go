@@ -0,0 +1,104 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/audit"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const verifyAuditLogBatch = 1000
+
+func dbToPbAuditLog(e *admin2.AuditLog) *admin.AuditLog {
+	return &admin.AuditLog{
+		Seq:        e.Seq,
+		Operator:   e.Operator,
+		Action:     e.Action,
+		Target:     e.Target,
+		Detail:     e.Detail,
+		Ip:         e.IP,
+		Error:      e.Error,
+		CreateTime: e.CreateTime.UnixMilli(),
+		PrevHash:   e.PrevHash,
+		Hash:       e.Hash,
+	}
+}
+
+func (o *adminServer) SearchAuditLog(ctx context.Context, req *admin.SearchAuditLogReq) (*admin.SearchAuditLogResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, constant.PermissionAudit); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		end = time.UnixMilli(req.EndTime)
+	}
+	total, logs, err := o.Audit.SearchAuditLog(ctx, req.Operator, req.Action, req.Keyword, start, end, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchAuditLogResp{Total: total, Logs: utils.Slice(logs, dbToPbAuditLog)}, nil
+}
+
+// VerifyAuditLog recomputes the hash chain from fromSeq to the last entry.
+func (o *adminServer) VerifyAuditLog(ctx context.Context, req *admin.VerifyAuditLogReq) (*admin.VerifyAuditLogResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, constant.PermissionAudit); err != nil {
+		return nil, err
+	}
+	var prev *admin2.AuditLog
+	seq := int64(1)
+	if req.FromSeq > 1 {
+		logs, err := o.Audit.RangeAuditLog(ctx, req.FromSeq-1, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) == 0 || logs[0].Seq != req.FromSeq-1 {
+			return nil, errs.ErrRecordNotFound.Wrap("audit log not found")
+		}
+		prev = logs[0]
+		seq = req.FromSeq
+	}
+	resp := &admin.VerifyAuditLogResp{Valid: true}
+	for {
+		logs, err := o.Audit.RangeAuditLog(ctx, seq, verifyAuditLogBatch)
+		if err != nil {
+			return nil, err
+		}
+		if brokenSeq, ok := audit.Verify(prev, logs); !ok {
+			resp.Valid = false
+			resp.BrokenSeq = brokenSeq
+			return resp, nil
+		}
+		resp.Count += int64(len(logs))
+		if len(logs) < verifyAuditLogBatch {
+			return resp, nil
+		}
+		prev = logs[len(logs)-1]
+		seq = prev.Seq + 1
+	}
+}
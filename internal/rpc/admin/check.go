@@ -27,11 +27,7 @@ import (
 
 func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.CheckRegisterForbiddenReq) (*admin.CheckRegisterForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
-	if err != nil {
-		return nil, err
-	}
-	for _, forbidden := range forbiddens {
+	for _, forbidden := range o.matchIPForbidden(req.Ip) {
 		if forbidden.LimitRegister {
 			return nil, eerrs.ErrForbidden.Wrap()
 		}
@@ -41,26 +37,15 @@ func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.Che
 
 func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckLoginForbiddenReq) (*admin.CheckLoginForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
-	if err != nil {
-		return nil, err
-	}
-	for _, forbidden := range forbiddens {
+	for _, forbidden := range o.matchIPForbidden(req.Ip) {
 		if forbidden.LimitLogin {
 			return nil, eerrs.ErrForbidden.Wrap("ip forbidden")
 		}
 	}
-	if _, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
-		if !dbutil.IsGormNotFound(err) {
-			return nil, err
-		}
-		count, err := o.Database.CountLimitUserLoginIP(ctx, req.UserID)
-		if err != nil {
-			return nil, err
-		}
-		if count > 0 {
-			return nil, eerrs.ErrForbidden.Wrap("user ip forbidden")
-		}
+	if allowed, err := o.checkUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
+		return nil, err
+	} else if !allowed {
+		return nil, eerrs.ErrForbidden.Wrap("user ip forbidden")
 	}
 	if forbiddenAccount, err := o.Database.GetBlockInfo(ctx, req.UserID); err == nil {
 		return nil, eerrs.ErrForbidden.Wrap(fmt.Sprintf("account forbidden: %s", forbiddenAccount.Reason))
@@ -20,8 +20,9 @@ import (
 	"github.com/OpenIMSDK/tools/log"
 
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/utils"
 
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
@@ -36,12 +37,17 @@ func (o *adminServer) GetClientConfig(ctx context.Context, req *admin.GetClientC
 
 func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientConfigReq) (*admin.SetClientConfigResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionClientConfig); err != nil {
 		return nil, err
 	}
 	if len(req.Config) == 0 {
 		return nil, errs.ErrArgs.Wrap("update config empty")
 	}
+	if _, ok := req.Config[constant.NeedAdminTwoFactorConfigKey]; ok {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	if err := o.Database.SetConfig(ctx, req.Config); err != nil {
 		return nil, err
 	}
@@ -50,9 +56,14 @@ func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientC
 
 func (o *adminServer) DelClientConfig(ctx context.Context, req *admin.DelClientConfigReq) (*admin.DelClientConfigResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionClientConfig); err != nil {
 		return nil, err
 	}
+	if utils.Contain(constant.NeedAdminTwoFactorConfigKey, req.Keys...) {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	if err := o.Database.DelConfig(ctx, req.Keys); err != nil {
 		return nil, err
 	}
@@ -19,16 +19,18 @@ import (
 	"time"
 
 
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
 
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIPForbiddenReq) (*admin.SearchIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
 	total, forbiddens, err := o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -45,6 +47,7 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    forbidden.CreateTime.UnixMilli(),
+			ExpireTime:    unixMilliOrZero(forbidden.ExpireTime),
 		})
 	}
 	return resp, nil
@@ -52,32 +55,51 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 
 func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbiddenReq) (*admin.AddIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
 	now := time.Now()
 	tables := make([]*admin2.IPForbidden, 0, len(req.Forbiddens))
 	for _, forbidden := range req.Forbiddens {
+		ip, err := iprule.Normalize(forbidden.Ip)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap(err.Error())
+		}
 		tables = append(tables, &admin2.IPForbidden{
-			IP:            forbidden.Ip,
+			IP:            ip,
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    now,
+			ExpireTime:    expireTimeOrNil(forbidden.ExpireTime),
 		})
 	}
 	if err := o.Database.AddIPForbidden(ctx, tables); err != nil {
 		return nil, err
 	}
+	if err := o.loadIPForbidden(ctx); err != nil {
+		log.ZError(ctx, "load ip forbidden failed", err)
+	}
 	return &admin.AddIPForbiddenResp{}, nil
 }
 
 func (o *adminServer) DelIPForbidden(ctx context.Context, req *admin.DelIPForbiddenReq) (*admin.DelIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
-	if err := o.Database.DelIPForbidden(ctx, req.Ips); err != nil {
+	ips := make([]string, 0, len(req.Ips))
+	for _, ip := range req.Ips {
+		// rows saved before normalization are deleted as they were given
+		if normalized, err := iprule.Normalize(ip); err == nil && normalized != ip {
+			ips = append(ips, normalized)
+		}
+		ips = append(ips, ip)
+	}
+	if err := o.Database.DelIPForbidden(ctx, ips); err != nil {
 		return nil, err
 	}
+	if err := o.loadIPForbidden(ctx); err != nil {
+		log.ZError(ctx, "load ip forbidden failed", err)
+	}
 	return &admin.DelIPForbiddenResp{}, nil
 }
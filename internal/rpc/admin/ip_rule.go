@@ -0,0 +1,111 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
+)
+
+// ipForbiddenReload is how long the changes made on another instance take to be matched.
+const ipForbiddenReload = time.Second * 10
+
+func ruleActive(expireTime *time.Time, now time.Time) bool {
+	return expireTime == nil || expireTime.After(now)
+}
+
+func unixMilliOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func expireTimeOrNil(ms int64) *time.Time {
+	if ms == 0 {
+		return nil
+	}
+	t := time.UnixMilli(ms)
+	return &t
+}
+
+func (o *adminServer) ipForbiddenLoop() {
+	for {
+		time.Sleep(ipForbiddenReload)
+		ctx := mcontext.NewCtx("ip_forbidden_" + strconv.FormatInt(time.Now().UnixMilli(), 10))
+		if err := o.loadIPForbidden(ctx); err != nil {
+			log.ZError(ctx, "load ip forbidden failed", err)
+		}
+	}
+}
+
+// loadIPForbidden builds the trie of every rule and replaces the cached one.
+func (o *adminServer) loadIPForbidden(ctx context.Context) error {
+	forbiddens, err := o.Database.FindAllIPForbidden(ctx)
+	if err != nil {
+		return err
+	}
+	trie := iprule.NewTrie[*admin2.IPForbidden]()
+	for _, forbidden := range forbiddens {
+		if err := trie.Insert(forbidden.IP, forbidden); err != nil {
+			log.ZWarn(ctx, "invalid ip forbidden", err, "ip", forbidden.IP)
+		}
+	}
+	o.ipForbidden.Store(trie)
+	return nil
+}
+
+// matchIPForbidden returns the rules that are not expired and contain the ip.
+func (o *adminServer) matchIPForbidden(ip string) []*admin2.IPForbidden {
+	trie := o.ipForbidden.Load()
+	if trie == nil {
+		return nil
+	}
+	now := time.Now()
+	var forbiddens []*admin2.IPForbidden
+	for _, forbidden := range trie.Lookup(ip) {
+		if ruleActive(forbidden.ExpireTime, now) {
+			forbiddens = append(forbiddens, forbidden)
+		}
+	}
+	return forbiddens
+}
+
+// checkUserLoginIP an user without active limits can login from any ip.
+func (o *adminServer) checkUserLoginIP(ctx context.Context, userID string, ip string) (bool, error) {
+	limits, err := o.Database.FindLimitUserLoginIP(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	var active bool
+	for _, limit := range limits {
+		if !ruleActive(limit.ExpireTime, now) {
+			continue
+		}
+		if iprule.Match(limit.IP, ip) {
+			return true, nil
+		}
+		active = true
+	}
+	return !active, nil
+}
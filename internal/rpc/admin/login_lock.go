@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const (
+	loginLockAdmin = "admin:"
+	loginLockIP    = "admin_ip:"
+)
+
+// loginFailed counts a wrong password, a is nil if the account does not exist.
+// It returns an error once the account gets locked.
+func (o *adminServer) loginFailed(ctx context.Context, a *admin2.Admin, ip string) error {
+	if ip != "" {
+		lockTime, err := loginlock.AddFail(ctx, o.Database, loginLockIP+ip, config.Config.LoginLock.IPMaxAttempts)
+		if err != nil {
+			return err
+		}
+		if lockTime > 0 {
+			log.ZWarn(ctx, "admin login ip locked", nil, "ip", ip, "lockTime", lockTime)
+		}
+	}
+	if a == nil {
+		return nil
+	}
+	lockTime, err := loginlock.AddFail(ctx, o.Database, loginLockAdmin+a.UserID, config.Config.LoginLock.MaxAttempts)
+	if err != nil {
+		return err
+	}
+	if lockTime == 0 {
+		return nil
+	}
+	log.ZWarn(ctx, "admin account locked", nil, "account", a.Account, "lockTime", lockTime)
+	return loginlock.Locked(lockTime)
+}
+
+func (o *adminServer) UnlockAdminLogin(ctx context.Context, req *admin.UnlockAdminLoginReq) (*admin.UnlockAdminLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(req.UserIDs)+len(req.Ips))
+	for _, userID := range req.UserIDs {
+		keys = append(keys, loginLockAdmin+userID)
+	}
+	for _, ip := range req.Ips {
+		keys = append(keys, loginLockIP+ip)
+	}
+	if err := o.Database.UnlockLogin(ctx, keys); err != nil {
+		return nil, err
+	}
+	return &admin.UnlockAdminLoginResp{}, nil
+}
@@ -0,0 +1,296 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+func isConfigAdmin(userID string) bool {
+	for _, admins := range [][]config.Admin{config.Config.AdminList, config.Config.ChatAdmin} {
+		for _, a := range admins {
+			if a.AdminID == userID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getPermissions returns the permissions of the admin, all is true when the admin is not limited by roles.
+// Super admins, the configured admins used by the services and admins without any role are not limited.
+// Api keys only get the permissions of their scopes.
+func (o *adminServer) getPermissions(ctx context.Context, userID string) (permissions []string, all bool, err error) {
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		if !dbutil.IsGormNotFound(err) {
+			return nil, false, err
+		}
+		if isConfigAdmin(userID) {
+			return nil, true, nil
+		}
+		// api keys act as admins named by the key id
+		key, err := o.Database.TakeApiKey(ctx, userID)
+		if err != nil {
+			if dbutil.IsGormNotFound(err) {
+				return nil, false, errs.ErrNoPermission.Wrap("admin not found")
+			}
+			return nil, false, err
+		}
+		for _, scope := range splitList(key.Scopes) {
+			permissions = append(permissions, constant.ApiKeyScopePermissions[scope]...)
+		}
+		return utils.Distinct(permissions), false, nil
+	}
+	if a.Level == constant.AdvancedUserLevel {
+		return nil, true, nil
+	}
+	adminRoles, err := o.Database.FindAdminRole(ctx, []string{userID})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(adminRoles) == 0 {
+		return nil, true, nil
+	}
+	roles, err := o.Database.FindRole(ctx, utils.Slice(adminRoles, func(e *admin2.AdminRole) string { return e.RoleID }))
+	if err != nil {
+		return nil, false, err
+	}
+	for _, role := range roles {
+		for _, permission := range splitList(role.Permissions) {
+			permissions = append(permissions, permission)
+			permissions = append(permissions, constant.ImpliedPermissions[permission]...)
+		}
+	}
+	return utils.Distinct(permissions), false, nil
+}
+
+// checkPermission checks that the admin of the context has the permission through one of its roles.
+func (o *adminServer) checkPermission(ctx context.Context, permission string) error {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	permissions, all, err := o.getPermissions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !all && !utils.Contain(permission, permissions...) {
+		return errs.ErrNoPermission.Wrap("no permission " + permission)
+	}
+	return nil
+}
+
+// checkUserPermission lets users act on themselves, admins acting on another user need the permission.
+func (o *adminServer) checkUserPermission(ctx context.Context, userID string, permission string) error {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if opUserID == userID {
+		return nil
+	}
+	if userType != constant.AdminUser {
+		return errs.ErrNoPermission.Wrap("not admin or not in userIDs")
+	}
+	return o.checkPermission(ctx, permission)
+}
+
+func (o *adminServer) CheckPermission(ctx context.Context, req *admin.CheckPermissionReq) (*admin.CheckPermissionResp, error) {
+	if err := o.checkPermission(ctx, req.Permission); err != nil {
+		return nil, err
+	}
+	return &admin.CheckPermissionResp{}, nil
+}
+
+// checkRolePermissions rejects names that are not in constant.Permissions.
+func checkRolePermissions(permissions []string) error {
+	for _, permission := range permissions {
+		if !utils.Contain(permission, constant.Permissions...) {
+			return errs.ErrArgs.Wrap("unknown permission " + permission)
+		}
+	}
+	return nil
+}
+
+func dbToPbRole(role *admin2.Role) *admin.Role {
+	return &admin.Role{
+		RoleID:      role.RoleID,
+		Name:        role.Name,
+		Permissions: splitList(role.Permissions),
+		Description: role.Description,
+		CreateTime:  role.CreateTime.UnixMilli(),
+	}
+}
+
+func (o *adminServer) AddRole(ctx context.Context, req *admin.AddRoleReq) (*admin.AddRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := checkRolePermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+	if req.RoleID == "" {
+		req.RoleID = o.genUserID()
+	} else if _, err := o.Database.TakeRole(ctx, req.RoleID); err == nil {
+		return nil, errs.ErrDuplicateKey.Wrap("role id exists")
+	} else if !dbutil.IsGormNotFound(err) {
+		return nil, err
+	}
+	role := &admin2.Role{
+		RoleID:      req.RoleID,
+		Name:        req.Name,
+		Permissions: strings.Join(utils.Distinct(req.Permissions), ","),
+		Description: req.Description,
+		CreateTime:  time.Now(),
+	}
+	if err := o.Database.CreateRole(ctx, []*admin2.Role{role}); err != nil {
+		return nil, err
+	}
+	return &admin.AddRoleResp{RoleID: role.RoleID}, nil
+}
+
+func (o *adminServer) UpdateRole(ctx context.Context, req *admin.UpdateRoleReq) (*admin.UpdateRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := checkRolePermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.TakeRole(ctx, req.RoleID); err != nil {
+		return nil, err
+	}
+	update := make(map[string]any)
+	if req.Name != nil {
+		update["name"] = req.Name.Value
+	}
+	if len(req.Permissions) > 0 || req.SetPermissions {
+		update["permissions"] = strings.Join(utils.Distinct(req.Permissions), ",")
+	}
+	if req.Description != nil {
+		update["description"] = req.Description.Value
+	}
+	if err := o.Database.UpdateRole(ctx, req.RoleID, update); err != nil {
+		return nil, err
+	}
+	return &admin.UpdateRoleResp{}, nil
+}
+
+func (o *adminServer) DelRole(ctx context.Context, req *admin.DelRoleReq) (*admin.DelRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	// removing the only role of an admin would silently lift every limit of the admin
+	adminRoles, err := o.Database.FindRoleAdmin(ctx, req.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(adminRoles) > 0 {
+		userIDs := utils.Distinct(utils.Slice(adminRoles, func(e *admin2.AdminRole) string { return e.UserID }))
+		return nil, errs.ErrArgs.Wrap("role is still assigned to admins " + strings.Join(userIDs, ","))
+	}
+	if err := o.Database.DelRole(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	return &admin.DelRoleResp{}, nil
+}
+
+func (o *adminServer) SearchRole(ctx context.Context, req *admin.SearchRoleReq) (*admin.SearchRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, roles, err := o.Database.SearchRole(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SearchRoleResp{Total: total, Roles: utils.Slice(roles, dbToPbRole)}, nil
+}
+
+// SetAdminRole replaces the roles of an admin, an admin without roles is not limited.
+func (o *adminServer) SetAdminRole(ctx context.Context, req *admin.SetAdminRoleReq) (*admin.SetAdminRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if a.Level == constant.AdvancedUserLevel {
+		return nil, errs.ErrArgs.Wrap("super admin has all permissions")
+	}
+	if len(req.RoleIDs) > 0 {
+		roles, err := o.Database.FindRole(ctx, req.RoleIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(roles) != len(req.RoleIDs) {
+			return nil, errs.ErrRecordNotFound.Wrap("role not found")
+		}
+	}
+	now := time.Now()
+	adminRoles := make([]*admin2.AdminRole, 0, len(req.RoleIDs))
+	for _, roleID := range req.RoleIDs {
+		adminRoles = append(adminRoles, &admin2.AdminRole{UserID: req.UserID, RoleID: roleID, CreateTime: now})
+	}
+	if err := o.Database.SetAdminRole(ctx, req.UserID, adminRoles); err != nil {
+		return nil, err
+	}
+	return &admin.SetAdminRoleResp{}, nil
+}
+
+func (o *adminServer) FindAdminRole(ctx context.Context, req *admin.FindAdminRoleReq) (*admin.FindAdminRoleResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	adminRoles, err := o.Database.FindAdminRole(ctx, req.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := o.Database.FindRole(ctx, utils.Distinct(utils.Slice(adminRoles, func(e *admin2.AdminRole) string { return e.RoleID })))
+	if err != nil {
+		return nil, err
+	}
+	roleMap := utils.SliceToMap(roles, func(e *admin2.Role) string { return e.RoleID })
+	userRoles := make(map[string][]*admin.Role)
+	for _, adminRole := range adminRoles {
+		if role, ok := roleMap[adminRole.RoleID]; ok {
+			userRoles[adminRole.UserID] = append(userRoles[adminRole.UserID], dbToPbRole(role))
+		}
+	}
+	resp := &admin.FindAdminRoleResp{AdminRoles: make([]*admin.AdminRole, 0, len(req.UserIDs))}
+	for _, userID := range utils.Distinct(req.UserIDs) {
+		resp.AdminRoles = append(resp.AdminRoles, &admin.AdminRole{UserID: userID, Roles: userRoles[userID]})
+	}
+	return resp, nil
+}
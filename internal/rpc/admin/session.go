@@ -0,0 +1,239 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+// sessionTouchInterval limits how often the last seen time of a session is written.
+const sessionTouchInterval = time.Minute
+
+// getSessions returns the live sessions of the user and drops the ones whose refresh tokens are gone.
+func (o *adminServer) getSessions(ctx context.Context, userID string) (map[string]*cache.Session, error) {
+	sessions, err := o.Database.GetSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var expired []string
+	for sessionID := range sessions {
+		exist, err := o.Database.ExistRefreshTokenFamily(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			expired = append(expired, sessionID)
+			delete(sessions, sessionID)
+		}
+	}
+	if err := o.Database.DelSessions(ctx, userID, expired); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (o *adminServer) touchSession(ctx context.Context, userID string, token string, ip string) {
+	sessions, err := o.Database.GetSessions(ctx, userID)
+	if err != nil {
+		log.ZError(ctx, "get sessions failed", err, "userID", userID)
+		return
+	}
+	now := time.Now()
+	for sessionID, session := range sessions {
+		if session.Token != token {
+			continue
+		}
+		if now.UnixMilli()-session.LastSeenTime < sessionTouchInterval.Milliseconds() && (ip == "" || ip == session.IP) {
+			return
+		}
+		session.LastSeenTime = now.UnixMilli()
+		if ip != "" {
+			session.IP = ip
+		}
+		if err := o.Database.SetSession(ctx, userID, sessionID, session, refreshTokenExpire()); err != nil {
+			log.ZError(ctx, "touch session failed", err, "userID", userID, "sessionID", sessionID)
+		}
+		return
+	}
+}
+
+func (o *adminServer) GetSessions(ctx context.Context, req *admin.GetSessionsReq) (*admin.GetSessionsResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkUserPermission(ctx, req.UserID, constant.PermissionUser); err != nil {
+		return nil, err
+	}
+	sessions, err := o.getSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.GetSessionsResp{Sessions: make([]*admin.Session, 0, len(sessions))}
+	for sessionID, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &admin.Session{
+			SessionID:    sessionID,
+			Platform:     session.Platform,
+			DeviceID:     session.DeviceID,
+			Ip:           session.IP,
+			LoginTime:    session.LoginTime,
+			LastSeenTime: session.LastSeenTime,
+			Current:      req.Token != "" && session.Token == req.Token,
+		})
+	}
+	sort.Slice(resp.Sessions, func(i, j int) bool {
+		return resp.Sessions[i].LastSeenTime > resp.Sessions[j].LastSeenTime
+	})
+	return resp, nil
+}
+
+func (o *adminServer) RevokeSessions(ctx context.Context, req *admin.RevokeSessionsReq) (*admin.RevokeSessionsResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkUserPermission(ctx, req.UserID, constant.PermissionUser); err != nil {
+		return nil, err
+	}
+	sessions, err := o.getSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	revoke := make(map[string]struct{})
+	for _, sessionID := range req.SessionIDs {
+		if _, ok := sessions[sessionID]; ok {
+			revoke[sessionID] = struct{}{}
+		}
+	}
+	for sessionID, session := range sessions {
+		current := req.Token != "" && session.Token == req.Token
+		if (current && req.Current) || (!current && req.Others) {
+			revoke[sessionID] = struct{}{}
+		}
+	}
+	// im only keeps one connection per platform, leave the platforms of the remaining sessions online.
+	keepPlatform := make(map[int32]struct{})
+	for sessionID, session := range sessions {
+		if _, ok := revoke[sessionID]; !ok {
+			keepPlatform[session.Platform] = struct{}{}
+		}
+	}
+	sessionIDs := make([]string, 0, len(revoke))
+	platforms := make([]int32, 0, len(revoke))
+	for sessionID := range revoke {
+		sessionIDs = append(sessionIDs, sessionID)
+		platform := sessions[sessionID].Platform
+		if _, ok := keepPlatform[platform]; !ok {
+			keepPlatform[platform] = struct{}{}
+			platforms = append(platforms, platform)
+		}
+	}
+	if err := o.Database.RevokeSessions(ctx, req.UserID, sessionIDs); err != nil {
+		return nil, err
+	}
+	if req.Others && req.Token == "" {
+		// revoking everything also kicks the tokens without a session, e.g. scope tokens.
+		if err := o.kickAllTokens(ctx, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return &admin.RevokeSessionsResp{Platforms: platforms}, nil
+}
+
+// kickAllTokens kicks every valid token of the user.
+func (o *adminServer) kickAllTokens(ctx context.Context, userID string) error {
+	tokens, err := o.Database.GetTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var kicked []string
+	for token, flag := range tokens {
+		if flag == constant2.NormalToken {
+			kicked = append(kicked, token)
+		}
+	}
+	return o.Database.KickTokens(ctx, userID, kicked)
+}
+
+// conflictPlatform reports whether a login on newPlatform kicks an older token of oldPlatform, following the im multi login policies.
+func conflictPlatform(policy int, newPlatform int32, oldPlatform int32) bool {
+	newClass := constant2.PlatformIDToClass(int(newPlatform))
+	oldClass := constant2.PlatformIDToClass(int(oldPlatform))
+	switch policy {
+	case constant2.AllLoginButSameTermKick:
+		return newPlatform == oldPlatform
+	case constant2.SingleTerminalLogin:
+		return true
+	case constant2.WebAndOther:
+		return newClass != constant2.WebPlatformStr && oldClass != constant2.WebPlatformStr
+	case constant2.PcMobileAndWeb:
+		if newClass == "" || newClass != oldClass {
+			return newPlatform == oldPlatform
+		}
+		return newClass != constant2.WebPlatformStr
+	case constant2.PCAndOther:
+		return newClass != constant2.TerminalPC && oldClass != constant2.TerminalPC
+	default:
+		return false
+	}
+}
+
+// kickConflictTokens kicks the sessions and tokens of the user that the multi login policy does not allow next to a new login on platform.
+func (o *adminServer) kickConflictTokens(ctx context.Context, userID string, platform int32) error {
+	policy := config.Config.TokenPolicy.MultiLoginPolicy
+	if policy == constant2.DefalutNotKick {
+		return nil
+	}
+	sessions, err := o.getSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var sessionIDs []string
+	for sessionID, session := range sessions {
+		if conflictPlatform(policy, platform, session.Platform) {
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+	if err := o.Database.RevokeSessions(ctx, userID, sessionIDs); err != nil {
+		return err
+	}
+	// tokens issued before sessions were tracked.
+	tokens, err := o.Database.GetTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var kicked []string
+	for token, flag := range tokens {
+		if flag != constant2.NormalToken {
+			continue
+		}
+		oldPlatform, err := tokenverify.GetTokenPlatform(token)
+		if err != nil {
+			continue
+		}
+		if conflictPlatform(policy, platform, oldPlatform) {
+			kicked = append(kicked, token)
+		}
+	}
+	if len(sessionIDs) > 0 || len(kicked) > 0 {
+		log.ZInfo(ctx, "kick conflict tokens", "userID", userID, "platform", platform, "sessionIDs", sessionIDs, "tokens", len(kicked))
+	}
+	return o.Database.KickTokens(ctx, userID, kicked)
+}
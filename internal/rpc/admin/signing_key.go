@@ -0,0 +1,212 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/tokenverify"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const (
+	// signingKeyReload is how often the keys are reloaded, a new key only signs once every instance had the time to load it.
+	signingKeyReload = time.Minute
+	// signingKeyLock keeps the replicas from each creating a key when the rotation is due.
+	signingKeyLock       = "signing_key_rotate"
+	signingKeyLockExpire = time.Second * 10
+	signingKeyLockWait   = 10
+)
+
+// signingKeyEncryption is the secret the private keys are encrypted with in the database.
+func signingKeyEncryption() string {
+	if key := config.Config.TokenPolicy.SigningKey.EncryptionKey; key != "" {
+		return key
+	}
+	return *config.Config.Secret
+}
+
+func signingAlgorithm() string {
+	if alg := config.Config.TokenPolicy.SigningKey.Algorithm; alg != "" {
+		return alg
+	}
+	return tokenverify.AlgHS256
+}
+
+// signingKeyLoop reloads the keys and rotates them when the newest one is older than the rotate interval.
+func (o *adminServer) signingKeyLoop() {
+	for {
+		time.Sleep(signingKeyReload)
+		ctx := mcontext.NewCtx("signing_key_" + strconv.FormatInt(time.Now().UnixMilli(), 10))
+		if err := o.loadSigningKeys(ctx); err != nil {
+			log.ZError(ctx, "load signing keys failed", err)
+		}
+	}
+}
+
+// loadSigningKeys sets the newest key which every instance has loaded as the signing key,
+// keys replaced longer than the grace period ago are deleted.
+func (o *adminServer) loadSigningKeys(ctx context.Context) error {
+	alg := signingAlgorithm()
+	if alg == tokenverify.AlgHS256 {
+		tokenverify.SetKeys(nil, nil)
+		return nil
+	}
+	if err := tokenverify.CheckAlgorithm(alg); err != nil {
+		return errs.ErrArgs.Wrap(err.Error())
+	}
+	ms, err := o.Database.FindSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	if needSigningKey(ms, alg) {
+		ms, err = o.rotateSigningKey(ctx, alg)
+		if err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+	// a key has to verify the access tokens it signed until they expire
+	grace := time.Duration(config.Config.TokenPolicy.SigningKey.GracePeriod) * time.Hour
+	if grace < accessTokenExpire() {
+		grace = accessTokenExpire()
+	}
+	var (
+		signing *tokenverify.Key
+		verify  []*tokenverify.Key
+		expired []string
+	)
+	for i, m := range ms {
+		// a key stops verifying when the grace period after its successor started signing is over
+		if i > 0 && now.Sub(ms[i-1].CreateTime) > 2*signingKeyReload+grace {
+			expired = append(expired, m.KID)
+			continue
+		}
+		data, err := tokenverify.DecryptPrivateKey(m.PrivateKey, signingKeyEncryption())
+		if err != nil {
+			log.ZError(ctx, "decrypt signing key failed", err, "kid", m.KID)
+			continue
+		}
+		privateKey, err := tokenverify.ParsePrivateKey(data)
+		if err != nil {
+			log.ZError(ctx, "parse signing key failed", err, "kid", m.KID)
+			continue
+		}
+		key := &tokenverify.Key{KID: m.KID, Algorithm: m.Algorithm, PrivateKey: privateKey, CreateTime: m.CreateTime}
+		verify = append(verify, key)
+		if m.Algorithm == alg && (signing == nil || now.Sub(signing.CreateTime) < 2*signingKeyReload) {
+			signing = key
+		}
+	}
+	if signing == nil {
+		return errs.ErrInternalServer.Wrap("no signing key")
+	}
+	tokenverify.SetKeys(signing, verify)
+	if len(expired) > 0 {
+		if err := o.Database.DelSigningKey(ctx, expired); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// needSigningKey reports whether a new key has to be created, ms is newest first.
+func needSigningKey(ms []*admin2.SigningKey, alg string) bool {
+	rotate := time.Duration(config.Config.TokenPolicy.SigningKey.RotateInterval) * time.Hour
+	return len(ms) == 0 || ms[0].Algorithm != alg || (rotate > 0 && time.Since(ms[0].CreateTime) > rotate)
+}
+
+// rotateSigningKey creates a new key under the rotate lock and returns the keys newest first.
+// The keys are read again once locked, so only the replica which got the lock first creates one.
+func (o *adminServer) rotateSigningKey(ctx context.Context, alg string) ([]*admin2.SigningKey, error) {
+	for i := 0; ; i++ {
+		token, err := o.Lock.TryLock(ctx, signingKeyLock, signingKeyLockExpire)
+		if err != nil {
+			return nil, err
+		}
+		if token != "" {
+			ms, err := o.createSigningKey(ctx, alg)
+			if err := o.Lock.Unlock(ctx, signingKeyLock, token); err != nil {
+				log.ZError(ctx, "signing key unlock failed", err)
+			}
+			return ms, err
+		}
+		// another replica is rotating, wait for its key
+		if i >= signingKeyLockWait {
+			return nil, errs.ErrInternalServer.Wrap("signing key rotation is locked")
+		}
+		time.Sleep(time.Second)
+		ms, err := o.Database.FindSigningKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !needSigningKey(ms, alg) {
+			return ms, nil
+		}
+	}
+}
+
+func (o *adminServer) createSigningKey(ctx context.Context, alg string) ([]*admin2.SigningKey, error) {
+	ms, err := o.Database.FindSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !needSigningKey(ms, alg) {
+		return ms, nil
+	}
+	key, err := tokenverify.GenerateKey(alg)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	privateKey, err := tokenverify.MarshalPrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	privateKey, err = tokenverify.EncryptPrivateKey(privateKey, signingKeyEncryption())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	m := &admin2.SigningKey{KID: key.KID, Algorithm: key.Algorithm, PrivateKey: privateKey, CreateTime: key.CreateTime}
+	if err := o.Database.CreateSigningKey(ctx, []*admin2.SigningKey{m}); err != nil {
+		return nil, err
+	}
+	log.ZInfo(ctx, "signing key created", "kid", key.KID, "algorithm", key.Algorithm)
+	return append([]*admin2.SigningKey{m}, ms...), nil
+}
+
+func (o *adminServer) GetJWKS(ctx context.Context, req *admin.GetJWKSReq) (*admin.GetJWKSResp, error) {
+	resp := &admin.GetJWKSResp{}
+	for _, jwk := range tokenverify.JWKS() {
+		resp.Keys = append(resp.Keys, &admin.JWK{
+			Kty: jwk.Kty,
+			Kid: jwk.Kid,
+			Alg: jwk.Alg,
+			Use: jwk.Use,
+			N:   jwk.N,
+			E:   jwk.E,
+			Crv: jwk.Crv,
+			X:   jwk.X,
+		})
+	}
+	return resp, nil
+}
@@ -16,26 +16,153 @@ package admin
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
 
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/chat/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
+// scopeTokenExpire is short, a limited token only lives for a single step such as changing the password.
+const scopeTokenExpire = time.Minute * 15
+
 func (o *adminServer) CreateToken(ctx context.Context, req *admin.CreateTokenReq) (*admin.CreateTokenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	token, err := tokenverify.CreateToken(req.UserID, req.UserType, *config.Config.TokenPolicy.Expire)
+	if req.Scope != "" {
+		token, err := tokenverify.CreateScopeToken(req.UserID, req.UserType, req.Platform, req.Scope, scopeTokenExpire)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.Database.CacheToken(ctx, req.UserID, token); err != nil {
+			return nil, err
+		}
+		return &admin.CreateTokenResp{Token: token}, nil
+	}
+	session := &cache.Session{
+		Platform:  req.Platform,
+		DeviceID:  req.DeviceID,
+		IP:        req.Ip,
+		LoginTime: time.Now().UnixMilli(),
+	}
+	if req.UserType == constant.NormalUser {
+		if err := o.kickConflictTokens(ctx, req.UserID, req.Platform); err != nil {
+			return nil, err
+		}
+	}
+	token, refreshToken, err := o.createToken(ctx, req.UserID, req.UserType, "", session)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.CacheToken(ctx, req.UserID, token)
+	return &admin.CreateTokenResp{
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// createToken issues an access token together with its refresh token, an empty family starts a new session.
+func (o *adminServer) createToken(ctx context.Context, userID string, userType int32, family string, session *cache.Session) (string, string, error) {
+	token, err := tokenverify.CreateToken(userID, userType, session.Platform, accessTokenExpire())
+	if err != nil {
+		return "", "", err
+	}
+	if err := o.Database.CacheToken(ctx, userID, token); err != nil {
+		return "", "", err
+	}
+	if family == "" {
+		family, err = genRandomToken(16)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	refreshToken, err := genRandomToken(32)
 	if err != nil {
+		return "", "", err
+	}
+	value := &cache.RefreshToken{
+		UserID:   userID,
+		UserType: userType,
+		Family:   family,
+	}
+	if err := o.Database.SetRefreshToken(ctx, refreshToken, value, token, refreshTokenExpire()); err != nil {
+		return "", "", err
+	}
+	session.Token = token
+	session.LastSeenTime = time.Now().UnixMilli()
+	if err := o.Database.SetSession(ctx, userID, family, session, refreshTokenExpire()); err != nil {
+		return "", "", err
+	}
+	return token, refreshToken, nil
+}
+
+func (o *adminServer) RefreshToken(ctx context.Context, req *admin.RefreshTokenReq) (*admin.RefreshTokenResp, error) {
+	defer log.ZDebug(ctx, "return")
+	value, err := o.Database.GetRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, errs.ErrTokenNotExist.Wrap("refresh token not exist")
+		}
 		return nil, err
 	}
-	return &admin.CreateTokenResp{
-		Token: token,
+	if value.UserType != req.UserType {
+		return nil, errs.ErrTokenInvalid.Wrap("token type error")
+	}
+	unused, err := o.Database.UseRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !unused {
+		// a used refresh token presented again means it leaked, log out every device of this login.
+		log.ZWarn(ctx, "refresh token reused", nil, "userID", value.UserID, "family", value.Family)
+		if err := o.Database.RevokeSessions(ctx, value.UserID, []string{value.Family}); err != nil {
+			return nil, err
+		}
+		return nil, errs.ErrTokenKicked.Wrap("refresh token has been used")
+	}
+	exist, err := o.Database.ExistRefreshTokenFamily(ctx, value.Family)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errs.ErrTokenKicked.Wrap("refresh token has been revoked")
+	}
+	switch value.UserType {
+	case constant.NormalUser:
+		if _, err := o.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{UserID: value.UserID, Ip: req.Ip}); err != nil {
+			return nil, err
+		}
+	case constant.AdminUser:
+		if err := o.checkAdminRefresh(ctx, value.UserID, req.Ip); err != nil {
+			return nil, err
+		}
+	}
+	sessions, err := o.Database.GetSessions(ctx, value.UserID)
+	if err != nil {
+		return nil, err
+	}
+	session, ok := sessions[value.Family]
+	if !ok {
+		session = &cache.Session{LoginTime: time.Now().UnixMilli()}
+	}
+	if req.Ip != "" {
+		session.IP = req.Ip
+	}
+	token, refreshToken, err := o.createToken(ctx, value.UserID, value.UserType, value.Family, session)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.RefreshTokenResp{
+		Token:        token,
+		RefreshToken: refreshToken,
+		UserID:       value.UserID,
 	}, nil
 }
 
@@ -44,9 +171,14 @@ func (*adminServer) ParseToken(ctx context.Context, req *admin.ParseTokenReq) (*
 	if err != nil {
 		return nil, err
 	}
+	scope, err := tokenverify.GetTokenScope(req.Token)
+	if err != nil {
+		return nil, err
+	}
 	return &admin.ParseTokenResp{
 		UserID:   userID,
 		UserType: userType,
+		Scope:    scope,
 	}, nil
 }
 
@@ -55,6 +187,31 @@ func (o *adminServer) GetUserToken(ctx context.Context, req *admin.GetUserTokenR
 	if err != nil {
 		return nil, err
 	}
+	if req.Token != "" && tokensMap[req.Token] == constant2.NormalToken {
+		o.touchSession(ctx, req.UserID, req.Token, req.Ip)
+	}
 
 	return &admin.GetUserTokenResp{TokensMap: tokensMap}, nil
 }
+
+func accessTokenExpire() time.Duration {
+	if config.Config.TokenPolicy.AccessExpire > 0 {
+		return time.Duration(config.Config.TokenPolicy.AccessExpire) * time.Minute
+	}
+	return time.Duration(*config.Config.TokenPolicy.Expire) * 24 * time.Hour
+}
+
+func refreshTokenExpire() time.Duration {
+	if config.Config.TokenPolicy.RefreshExpire > 0 {
+		return time.Duration(config.Config.TokenPolicy.RefreshExpire) * 24 * time.Hour
+	}
+	return time.Duration(*config.Config.TokenPolicy.Expire) * 24 * time.Hour
+}
+
+func genRandomToken(n int) (string, error) {
+	data := make([]byte, n)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
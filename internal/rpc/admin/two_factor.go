@@ -0,0 +1,373 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/totp"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const (
+	twoFactorChallengeExpire     = time.Minute * 5
+	twoFactorChallengeMaxAttempt = 5
+	twoFactorRecoveryCodeCount   = 10
+)
+
+func (o *adminServer) needTwoFactor(ctx context.Context) (bool, error) {
+	conf, err := o.Database.GetConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	return utils.Contain(strings.ToLower(conf[constant.NeedAdminTwoFactorConfigKey]), "1", "true", "yes"), nil
+}
+
+// twoFactorChallenge returns a non-empty challenge when the login has to be completed with a second factor.
+func (o *adminServer) twoFactorChallenge(ctx context.Context, a *admin2.Admin, ip string) (string, bool, error) {
+	if !a.TwoFactorEnabled {
+		required, err := o.needTwoFactor(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		if !required {
+			return "", false, nil
+		}
+	}
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", false, errs.Wrap(err)
+	}
+	challenge := hex.EncodeToString(data)
+	value := &cache.LoginChallenge{
+		UserID:   a.UserID,
+		UserType: constant.AdminUser,
+		IP:       ip,
+		Setup:    !a.TwoFactorEnabled,
+	}
+	if err := o.Database.SetLoginChallenge(ctx, challenge, value, twoFactorChallengeExpire); err != nil {
+		return "", false, err
+	}
+	return challenge, !a.TwoFactorEnabled, nil
+}
+
+func (o *adminServer) takeLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error) {
+	if challenge == "" {
+		return nil, errs.ErrArgs.Wrap("challenge is empty")
+	}
+	value, err := o.Database.GetLoginChallenge(ctx, challenge)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap()
+		}
+		return nil, err
+	}
+	if value.UserType != constant.AdminUser {
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("challenge type error")
+	}
+	return value, nil
+}
+
+// checkTwoFactorCode validates a totp code or, when code is empty, consumes a recovery code.
+func (o *adminServer) checkTwoFactorCode(ctx context.Context, a *admin2.Admin, code string, recoveryCode string) error {
+	if code != "" {
+		step, ok := totp.Validate(a.TwoFactorSecret, code, time.Now(), a.TwoFactorLastStep)
+		if !ok {
+			return eerrs.ErrTwoFactorCodeNotMatch.Wrap()
+		}
+		ok, err := o.Database.UpdateAdminTwoFactorStep(ctx, a.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTwoFactorCodeNotMatch.Wrap("code already used")
+		}
+		return nil
+	}
+	if recoveryCode == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	hash := totp.HashRecoveryCode(recoveryCode)
+	codes := utils.Filter(strings.Split(a.RecoveryCodes, ","), func(s string) (string, bool) { return s, s != "" })
+	index := utils.IndexOf(hash, codes...)
+	if index < 0 {
+		return eerrs.ErrTwoFactorCodeNotMatch.Wrap("recovery code not match")
+	}
+	codes = append(codes[:index], codes[index+1:]...)
+	ok, err := o.Database.UseAdminRecoveryCode(ctx, a.UserID, a.RecoveryCodes, codes)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return eerrs.ErrTwoFactorCodeNotMatch.Wrap("recovery code already used")
+	}
+	return nil
+}
+
+func (o *adminServer) setupTwoFactor(ctx context.Context, userID string) (*admin.SetupTwoFactorResp, error) {
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if a.TwoFactorEnabled {
+		return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"two_factor_secret":    secret,
+		"two_factor_last_step": 0,
+		"recovery_codes":       "",
+	}
+	if err := o.Database.UpdateAdmin(ctx, userID, update); err != nil {
+		return nil, err
+	}
+	return &admin.SetupTwoFactorResp{Secret: secret, Uri: totp.URI(config.Config.TwoFactor.Issuer, a.Account, secret)}, nil
+}
+
+// enableTwoFactor confirms the pending secret with the first code and returns the recovery codes.
+func (o *adminServer) enableTwoFactor(ctx context.Context, userID string, code string) ([]string, error) {
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if a.TwoFactorEnabled {
+		return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+	}
+	if a.TwoFactorSecret == "" {
+		return nil, errs.ErrArgs.Wrap("two factor not set up")
+	}
+	step, ok := totp.Validate(a.TwoFactorSecret, code, time.Now(), 0)
+	if !ok {
+		return nil, eerrs.ErrTwoFactorCodeNotMatch.Wrap()
+	}
+	codes, err := totp.GenerateRecoveryCodes(twoFactorRecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"two_factor_enabled":   true,
+		"two_factor_last_step": step,
+		"recovery_codes":       strings.Join(utils.Slice(codes, totp.HashRecoveryCode), ","),
+	}
+	if err := o.Database.UpdateAdmin(ctx, userID, update); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (o *adminServer) SetupTwoFactor(ctx context.Context, req *admin.SetupTwoFactorReq) (*admin.SetupTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if req.Challenge != "" {
+		value, err := o.takeLoginChallenge(ctx, req.Challenge)
+		if err != nil {
+			return nil, err
+		}
+		if !value.Setup {
+			return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+		}
+		return o.setupTwoFactor(ctx, value.UserID)
+	}
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.setupTwoFactor(ctx, userID)
+}
+
+func (o *adminServer) EnableTwoFactor(ctx context.Context, req *admin.EnableTwoFactorReq) (*admin.EnableTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := o.enableTwoFactor(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.EnableTwoFactorResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) DisableTwoFactor(ctx context.Context, req *admin.DisableTwoFactorReq) (*admin.DisableTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required, err := o.needTwoFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		return nil, eerrs.ErrTwoFactorRequired.Wrap()
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !a.TwoFactorEnabled {
+		return &admin.DisableTwoFactorResp{}, nil
+	}
+	if err := o.checkTwoFactorCode(ctx, a, req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, userID, o.resetTwoFactorUpdate()); err != nil {
+		return nil, err
+	}
+	return &admin.DisableTwoFactorResp{}, nil
+}
+
+func (o *adminServer) GenTwoFactorRecoveryCodes(ctx context.Context, req *admin.GenTwoFactorRecoveryCodesReq) (*admin.GenTwoFactorRecoveryCodesResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !a.TwoFactorEnabled {
+		return nil, errs.ErrArgs.Wrap("two factor not enabled")
+	}
+	if err := o.checkTwoFactorCode(ctx, a, req.Code, ""); err != nil {
+		return nil, err
+	}
+	codes, err := totp.GenerateRecoveryCodes(twoFactorRecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, userID, map[string]any{"recovery_codes": strings.Join(utils.Slice(codes, totp.HashRecoveryCode), ",")}); err != nil {
+		return nil, err
+	}
+	return &admin.GenTwoFactorRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) TwoFactorLogin(ctx context.Context, req *admin.TwoFactorLoginReq) (*admin.TwoFactorLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	value, err := o.takeLoginChallenge(ctx, req.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{loginLockAdmin + value.UserID}
+	if value.IP != "" {
+		keys = append(keys, loginLockIP+value.IP)
+	}
+	if err := loginlock.Check(ctx, o.Database, keys...); err != nil {
+		return nil, err
+	}
+	attempt, err := o.Database.IncrLoginChallengeAttempt(ctx, req.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	if attempt > twoFactorChallengeMaxAttempt {
+		if err := o.Database.DelLoginChallenge(ctx, req.Challenge); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("too many attempts")
+	}
+	resp := &admin.TwoFactorLoginResp{}
+	resp.RecoveryCodes, err = o.checkSecondFactor(ctx, value, req.Code, req.RecoveryCode)
+	if err != nil {
+		// the second factor counts towards the same lock as the password, a new challenge does not reset it.
+		a, adminErr := o.Database.GetAdminUserID(ctx, value.UserID)
+		if adminErr != nil {
+			return nil, adminErr
+		}
+		if lockErr := o.loginFailed(ctx, a, value.IP); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+	if err := o.Database.DelLoginChallenge(ctx, req.Challenge); err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, value.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelLoginFail(ctx, loginLockAdmin+a.UserID); err != nil {
+		log.ZError(ctx, "reset admin login failures failed", err, "account", a.Account)
+	}
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser, Platform: constant2.AdminPlatformID, Ip: value.IP})
+	if err != nil {
+		return nil, err
+	}
+	resp.AdminUserID = a.UserID
+	resp.AdminAccount = a.Account
+	resp.AdminToken = adminToken.Token
+	resp.AdminRefreshToken = adminToken.RefreshToken
+	resp.Nickname = a.Nickname
+	resp.FaceURL = a.FaceURL
+	resp.Level = a.Level
+	return resp, nil
+}
+
+func (o *adminServer) ResetAdminTwoFactor(ctx context.Context, req *admin.ResetAdminTwoFactorReq) (*admin.ResetAdminTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdminUserID(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, req.UserID, o.resetTwoFactorUpdate()); err != nil {
+		return nil, err
+	}
+	return &admin.ResetAdminTwoFactorResp{}, nil
+}
+
+func (o *adminServer) resetTwoFactorUpdate() map[string]any {
+	return map[string]any{
+		"two_factor_secret":    "",
+		"two_factor_enabled":   false,
+		"two_factor_last_step": 0,
+		"recovery_codes":       "",
+	}
+}
+
+// checkSecondFactor verifies the code of the challenge, it returns the recovery codes when two-factor authentication got set up by it.
+func (o *adminServer) checkSecondFactor(ctx context.Context, value *cache.LoginChallenge, code string, recoveryCode string) ([]string, error) {
+	if value.Setup {
+		return o.enableTwoFactor(ctx, value.UserID, code)
+	}
+	a, err := o.Database.GetAdminUserID(ctx, value.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !a.TwoFactorEnabled {
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("two factor disabled")
+	}
+	return nil, o.checkTwoFactorCode(ctx, a, code, recoveryCode)
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 
+	"github.com/OpenIMSDK/chat/pkg/common/password"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
@@ -46,7 +47,11 @@ func ToDBAdminUpdate(req *admin.AdminUpdateInfoReq) (map[string]any, error) {
 		if req.Password.Value == "" {
 			return nil, errs.ErrArgs.Wrap("password is empty")
 		}
-		update["password"] = req.Password.Value
+		hashedPassword, err := password.Hash(req.Password.Value)
+		if err != nil {
+			return nil, err
+		}
+		update["password"] = hashedPassword
 	}
 	if req.FaceURL != nil {
 		update["face_url"] = req.FaceURL.Value
@@ -69,11 +74,15 @@ func ToDBAdminUpdate(req *admin.AdminUpdateInfoReq) (map[string]any, error) {
 	return update, nil
 }
 
-func ToDBAdminUpdatePassword(password string) (map[string]any, error) {
-	if password == "" {
+func ToDBAdminUpdatePassword(pwd string) (map[string]any, error) {
+	if pwd == "" {
 		return nil, errs.ErrArgs.Wrap("password is empty")
 	}
-	return map[string]any{"password": password}, nil
+	hashedPassword, err := password.Hash(pwd)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"password": hashedPassword}, nil
 }
 
 func ToDBAppletUpdate(req *admin.UpdateAppletReq) (map[string]any, error) {
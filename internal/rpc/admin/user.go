@@ -61,11 +61,10 @@ func (o *adminServer) CancellationUser(ctx context.Context, req *admin.Cancellat
 
 func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*admin.BlockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	_, err := mctx.CheckAdmin(ctx)
-	if err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionBlock); err != nil {
 		return nil, err
 	}
-	_, err = o.Database.GetBlockInfo(ctx, req.UserID)
+	_, err := o.Database.GetBlockInfo(ctx, req.UserID)
 	if err == nil {
 		return nil, errs.ErrArgs.Wrap("user already blocked")
 	} else if !dbutil.IsGormNotFound(err) {
@@ -86,7 +85,7 @@ func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*
 
 func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq) (*admin.UnblockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionBlock); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -111,7 +110,7 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 
 func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBlockUserReq) (*admin.SearchBlockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionBlock); err != nil {
 		return nil, err
 	}
 	log.ZInfo(ctx, "SearchBlockUser", "RpcOpUserID", ctx.Value(constant.RpcOpUserID), "RpcOpUserType", ctx.Value(constant.RpcOpUserType))
@@ -24,14 +24,15 @@ import (
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/utils"
 
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.SearchUserIPLimitLoginReq) (*admin.SearchUserIPLimitLoginResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchUserLimitLogin(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -50,6 +51,7 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 			Ip:         info.IP,
 			CreateTime: info.CreateTime.UnixMilli(),
 			User:       userMap[info.UserID],
+			ExpireTime: unixMilliOrZero(info.ExpireTime),
 		})
 	}
 	return &admin.SearchUserIPLimitLoginResp{Total: total, Limits: limits}, nil
@@ -57,7 +59,7 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 
 func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUserIPLimitLoginReq) (*admin.AddUserIPLimitLoginResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -66,10 +68,15 @@ func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUse
 	now := time.Now()
 	ts := make([]*admin2.LimitUserLoginIP, 0, len(req.Limits))
 	for _, limit := range req.Limits {
+		ip, err := iprule.Normalize(limit.Ip)
+		if err != nil {
+			return nil, errs.ErrArgs.Wrap(err.Error())
+		}
 		ts = append(ts, &admin2.LimitUserLoginIP{
 			UserID:     limit.UserID,
-			IP:         limit.Ip,
+			IP:         ip,
 			CreateTime: now,
+			ExpireTime: expireTimeOrNil(limit.ExpireTime),
 		})
 	}
 	if err := o.Database.AddUserLimitLogin(ctx, ts); err != nil {
@@ -79,7 +86,7 @@ func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUse
 }
 
 func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUserIPLimitLoginReq) (*admin.DelUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, constant.PermissionForbidden); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -94,6 +101,13 @@ func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUse
 			UserID: limit.UserID,
 			IP:     limit.Ip,
 		})
+		// rows saved before normalization are deleted as they were given
+		if ip, err := iprule.Normalize(limit.Ip); err == nil && ip != limit.Ip {
+			ts = append(ts, &admin2.LimitUserLoginIP{
+				UserID: limit.UserID,
+				IP:     ip,
+			})
+		}
 	}
 	if err := o.Database.DelUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
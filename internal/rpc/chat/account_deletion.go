@@ -0,0 +1,140 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/dataexport"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/password"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	accountDeletionInterval = time.Hour
+	accountDeletionBatch    = 100
+)
+
+// DeleteAccount schedules the deletion of the account, logging in again within the grace period cancels it.
+func (o *chatSvr) DeleteAccount(ctx context.Context, req *chat.DeleteAccountReq) (*chat.DeleteAccountResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := o.Database.GetAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account.Password != "" {
+		if match, _ := password.Verify(account.Password, req.Password); !match {
+			return nil, errs.ErrNoPermission.Wrap("password is wrong")
+		}
+	}
+	deletionTime := time.Now().Add(time.Duration(config.Config.AccountDeletion.GracePeriod) * 24 * time.Hour)
+	if err := o.Database.SetAccountDeletion(ctx, userID, &deletionTime); err != nil {
+		return nil, err
+	}
+	return &chat.DeleteAccountResp{DeletionTime: deletionTime.UnixMilli()}, nil
+}
+
+// cancelAccountDeletion keeps the account of a user logging in during the grace period.
+func (o *chatSvr) cancelAccountDeletion(ctx context.Context, userID string) error {
+	account, err := o.Database.GetAccount(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if account.DeletionTime == nil {
+		return nil
+	}
+	log.ZInfo(ctx, "cancel account deletion", "userID", userID, "deletionTime", *account.DeletionTime)
+	return o.Database.SetAccountDeletion(ctx, userID, nil)
+}
+
+func (o *chatSvr) accountDeletionLoop() {
+	for {
+		ctx := mctx.WithAdminUser(mcontext.NewCtx("account_deletion_" + strconv.FormatInt(time.Now().UnixMilli(), 10)))
+		o.purgeDeletedAccount(ctx)
+		time.Sleep(accountDeletionInterval)
+	}
+}
+
+// purgeDeletedAccount purges the accounts whose grace period is over, a failed one is skipped and retried in the next round.
+func (o *chatSvr) purgeDeletedAccount(ctx context.Context) {
+	before := time.Now()
+	var last *chat2.Account
+	for {
+		accounts, err := o.Database.FindAccountDeletion(ctx, before, last, accountDeletionBatch)
+		if err != nil {
+			log.ZError(ctx, "find account deletion failed", err)
+			return
+		}
+		for _, account := range accounts {
+			if err := o.purgeAccount(ctx, account.UserID); err != nil {
+				log.ZError(ctx, "purge account failed", err, "userID", account.UserID)
+				continue
+			}
+			log.ZInfo(ctx, "purge account", "userID", account.UserID)
+		}
+		if len(accounts) < accountDeletionBatch {
+			return
+		}
+		last = accounts[len(accounts)-1]
+	}
+}
+
+// purgeAccount deletes the data of the user in the other services first, so the account stays scheduled until everything is gone.
+func (o *chatSvr) purgeAccount(ctx context.Context, userID string) error {
+	if err := o.Office.DeleteUserData(ctx, []string{userID}); err != nil {
+		return err
+	}
+	if err := o.Organization.DeleteUserAllDepartment(ctx, []string{userID}); err != nil {
+		return err
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if err := o.Admin.RevokeAllSessions(ctx, userID); err != nil {
+		return err
+	}
+	if err := o.imApiCaller.UnregisterUser(mctx.WithApiToken(ctx, imToken), userID); err != nil {
+		return err
+	}
+	exportIDs, err := o.Database.FindDataExportID(ctx, []string{userID})
+	if err != nil {
+		return err
+	}
+	if err := o.Database.DeleteUser(ctx, []string{userID}); err != nil {
+		return err
+	}
+	// left over files are removed by the data export purge once they expire.
+	if err := dataexport.Remove(dataExportDir(), exportIDs...); err != nil {
+		log.ZError(ctx, "remove data export files failed", err, "userID", userID)
+	}
+	return nil
+}
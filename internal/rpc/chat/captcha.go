@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/chat/pkg/common/captcha"
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	captchaIP       = "ip:"
+	captchaAreaCode = "area:"
+	captchaDevice   = "device:"
+)
+
+func captchaExpire() time.Duration {
+	if config.Config.Captcha.Expire <= 0 {
+		return time.Minute * 5
+	}
+	return time.Duration(config.Config.Captcha.Expire) * time.Second
+}
+
+func captchaWindow() time.Duration {
+	if config.Config.Captcha.Window <= 0 {
+		return time.Hour
+	}
+	return time.Duration(config.Config.Captcha.Window) * time.Second
+}
+
+// registerCaptcha applies the config to the built-in generators.
+func registerCaptcha() {
+	if config.Config.Captcha.PoWDifficulty > 0 {
+		captcha.Register(captcha.TypePoW, &captcha.PoW{Difficulty: config.Config.Captcha.PoWDifficulty})
+	}
+}
+
+func (o *chatSvr) GetCaptcha(ctx context.Context, req *chat.GetCaptchaReq) (*chat.GetCaptchaResp, error) {
+	defer log.ZDebug(ctx, "return")
+	g := captcha.Get(config.Config.Captcha.Type)
+	if g == nil {
+		return nil, errs.ErrNoPermission.Wrap("captcha disabled")
+	}
+	c, answer, err := g.Generate()
+	if err != nil {
+		return nil, err
+	}
+	captchaID := uuid.New().String()
+	if err := o.Database.SetCaptcha(ctx, captchaID, c.Type, answer, captchaExpire()); err != nil {
+		return nil, err
+	}
+	return &chat.GetCaptchaResp{
+		CaptchaID:  captchaID,
+		Type:       c.Type,
+		Image:      c.Image,
+		Piece:      c.Piece,
+		PieceY:     int32(c.PieceY),
+		Prefix:     c.Prefix,
+		Difficulty: int32(c.Difficulty),
+	}, nil
+}
+
+// checkCaptcha counts the request against every threshold and requires a solved challenge once one is reached.
+func (o *chatSvr) checkCaptcha(ctx context.Context, req *chat.SendVerifyCodeReq) error {
+	conf := config.Config.Captcha
+	if captcha.Get(conf.Type) == nil {
+		return nil
+	}
+	thresholds := make(map[string]int64)
+	if req.Ip != "" && conf.IPThreshold > 0 {
+		thresholds[captchaIP+req.Ip] = conf.IPThreshold
+	}
+	if req.Email == "" && req.AreaCode != "" && conf.AreaCodeThreshold > 0 {
+		thresholds[captchaAreaCode+req.AreaCode] = conf.AreaCodeThreshold
+	}
+	if req.DeviceID != "" && conf.DeviceThreshold > 0 {
+		thresholds[captchaDevice+req.DeviceID] = conf.DeviceThreshold
+	}
+	var required bool
+	for key, threshold := range thresholds {
+		count, err := o.Database.IncrVerifyCodeSend(ctx, key, captchaWindow())
+		if err != nil {
+			return err
+		}
+		if count > threshold {
+			required = true
+		}
+	}
+	if !required {
+		return nil
+	}
+	if req.CaptchaID == "" {
+		return eerrs.ErrCaptchaRequired.Wrap()
+	}
+	typ, answer, err := o.Database.TakeCaptcha(ctx, req.CaptchaID)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return eerrs.ErrCaptchaNotMatch.Wrap("captcha expired")
+		}
+		return err
+	}
+	g := captcha.Get(typ)
+	if g == nil || !g.Verify(answer, req.CaptchaAnswer) {
+		return eerrs.ErrCaptchaNotMatch.Wrap()
+	}
+	return nil
+}
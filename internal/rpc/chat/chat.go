@@ -21,9 +21,13 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/chat/pkg/common/db/database"
 	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+	"github.com/OpenIMSDK/chat/pkg/common/directory"
+	"github.com/OpenIMSDK/chat/pkg/common/geoip"
+	"github.com/OpenIMSDK/chat/pkg/common/oidc"
 	"github.com/OpenIMSDK/chat/pkg/email"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	chatClient "github.com/OpenIMSDK/chat/pkg/rpclient/chat"
@@ -42,32 +46,60 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		chat2.VerifyCode{},
 		chat2.UserLoginRecord{},
 		chat2.Log{},
+		chat2.UserTwoFactor{},
+		chat2.ExternalIdentity{},
+		chat2.PasswordHistory{},
+		chat2.DataExport{},
+		chat2.MailTemplate{},
 	}
 	if err := db.AutoMigrate(tables...); err != nil {
 		return errs.Wrap(err)
 	}
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return errs.Wrap(err)
+	}
 	s, err := sms.New()
 	if err != nil {
 		return errs.Wrap(err)
 	}
-	email := email.NewMail()
-	if err := discov.CreateRpcRootNodes([]string{config.Config.RpcRegisterName.OpenImAdminName, config.Config.RpcRegisterName.OpenImChatName}); err != nil {
+	chatDB := database.NewChatDatabase(db, rdb)
+	geoIP, err := newGeoIP()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := discov.CreateRpcRootNodes([]string{config.Config.RpcRegisterName.OpenImAdminName, config.Config.RpcRegisterName.OpenImChatName, config.Config.RpcRegisterName.OpenImOfficeName, config.Config.RpcRegisterName.OpenImOrganizationName}); err != nil {
 		panic(errs.Wrap(err, "CreateRpcRootNodes error"))
 	}
-	chat.RegisterChatServer(server, &chatSvr{
-		Database:    database.NewChatDatabase(db),
-		Admin:       chatClient.NewAdminClient(discov),
-		SMS:         s,
-		Mail:        email,
-		imApiCaller: apicall.NewCallerInterface(),
-	})
+	srv := &chatSvr{
+		Database:     chatDB,
+		Admin:        chatClient.NewAdminClient(discov),
+		Office:       chatClient.NewOfficeClient(discov),
+		Organization: chatClient.NewOrganizationClient(discov),
+		SMS:          s,
+		Mail:         email.NewMail(chatDB),
+		imApiCaller:  apicall.NewCallerInterface(),
+		OIDC:         newOIDCProviders(),
+		Directory:    directory.NewFromConfig(),
+		GeoIP:        geoIP,
+	}
+	registerCaptcha()
+	go srv.loginRecordPurgeLoop()
+	go srv.accountDeletionLoop()
+	go srv.dataExportPurgeLoop()
+	chat.RegisterChatServer(server, srv)
 	return nil
 }
 
 type chatSvr struct {
-	Database    database.ChatDatabaseInterface
-	Admin       *chatClient.AdminClient
-	SMS         sms.SMS
-	Mail        email.Mail
-	imApiCaller apicall.CallerInterface
+	Database     database.ChatDatabaseInterface
+	Admin        *chatClient.AdminClient
+	Office       *chatClient.OfficeClient
+	Organization *chatClient.OrganizationClient
+	SMS          sms.SMS
+	Mail         email.Mail
+	imApiCaller  apicall.CallerInterface
+	OIDC         map[string]*oidc.Provider
+	Directory    *directory.Directory
+	GeoIP        *geoip.DB
 }
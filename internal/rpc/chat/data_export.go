@@ -0,0 +1,320 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/dataexport"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	dataExportDownloadPath  = "/account/export/download"
+	dataExportPurgeInterval = time.Hour
+	dataExportPurgeBatch    = 100
+	dataExportPageSize      = 1000
+)
+
+func dataExportURLExpire() time.Duration {
+	if config.Config.DataExport.URLExpire <= 0 {
+		return time.Minute * 10
+	}
+	return time.Duration(config.Config.DataExport.URLExpire) * time.Second
+}
+
+func dataExportRetention() time.Duration {
+	if config.Config.DataExport.Retention <= 0 {
+		return time.Hour * 72
+	}
+	return time.Duration(config.Config.DataExport.Retention) * time.Hour
+}
+
+func dataExportTimeout() time.Duration {
+	if config.Config.DataExport.Timeout <= 0 {
+		return time.Hour
+	}
+	return time.Duration(config.Config.DataExport.Timeout) * time.Second
+}
+
+func dataExportDir() string {
+	return dataexport.Dir(config.Config.DataExport.Dir)
+}
+
+// dataExportUserID returns the user whose data is exported, users can only export their own data.
+func (o *chatSvr) dataExportUserID(ctx context.Context, userID string) (string, string, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	switch userType {
+	case constant.NormalUser:
+		if userID != "" && userID != opUserID {
+			return "", "", errs.ErrNoPermission.Wrap("no permission export other user data")
+		}
+		return opUserID, opUserID, nil
+	case constant.AdminUser:
+		if userID == "" {
+			return "", "", errs.ErrArgs.Wrap("user id must be set")
+		}
+		if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+			return "", "", err
+		}
+		return opUserID, userID, nil
+	default:
+		return "", "", errs.ErrInternalServer.Wrap("invalid user type")
+	}
+}
+
+func DbToPbDataExport(export *chat2.DataExport) *chat.DataExport {
+	pb := &chat.DataExport{
+		ExportID:   export.ExportID,
+		UserID:     export.UserID,
+		Status:     export.Status,
+		Error:      export.Error,
+		Size:       export.Size,
+		CreateTime: export.CreateTime.UnixMilli(),
+		ExpireTime: export.ExpireTime.UnixMilli(),
+	}
+	if export.FinishTime != nil {
+		pb.FinishTime = export.FinishTime.UnixMilli()
+	}
+	if export.Status == constant.DataExportSuccess {
+		expire := time.Now().Add(dataExportURLExpire())
+		if expire.After(export.ExpireTime) {
+			expire = export.ExpireTime
+		}
+		url := config.Config.DataExport.URL
+		if url == "" {
+			url = dataExportDownloadPath
+		}
+		pb.Url = url + "?token=" + dataexport.Sign(*config.Config.Secret, export.ExportID, expire)
+	}
+	return pb
+}
+
+// StartDataExport starts exporting the data of the user, an export of the user which is still running is returned instead.
+func (o *chatSvr) StartDataExport(ctx context.Context, req *chat.StartDataExportReq) (*chat.StartDataExportResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, userID, err := o.dataExportUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAttribute(ctx, userID); err != nil {
+		return nil, err
+	}
+	latest, err := o.Database.TakeLatestDataExport(ctx, userID)
+	if err == nil && latest.Status == constant.DataExportRunning {
+		if time.Since(latest.CreateTime) < dataExportTimeout() {
+			return &chat.StartDataExportResp{Export: DbToPbDataExport(latest)}, nil
+		}
+		// the export was lost, e.g. chat-rpc restarted while running it.
+		log.ZWarn(ctx, "data export timed out", nil, "exportID", latest.ExportID, "userID", userID)
+		if err := o.Database.FinishDataExport(ctx, latest.ExportID, constant.DataExportFailed, "export timed out", 0); err != nil {
+			return nil, err
+		}
+	} else if err != nil && !o.Database.IsNotFound(err) {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now()
+	export := &chat2.DataExport{
+		ExportID:       hex.EncodeToString(id),
+		UserID:         userID,
+		OperatorUserID: opUserID,
+		Status:         constant.DataExportRunning,
+		CreateTime:     now,
+		ExpireTime:     now.Add(dataExportRetention()),
+	}
+	if err := o.Database.CreateDataExport(ctx, export); err != nil {
+		return nil, err
+	}
+	go o.runDataExport(mctx.WithAdminUser(mcontext.NewCtx("data_export_"+export.ExportID)), export.ExportID, userID)
+	return &chat.StartDataExportResp{Export: DbToPbDataExport(export)}, nil
+}
+
+func (o *chatSvr) GetDataExport(ctx context.Context, req *chat.GetDataExportReq) (*chat.GetDataExportResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if req.ExportID != "" {
+		export, err := o.Database.TakeDataExport(ctx, req.ExportID)
+		if err != nil {
+			return nil, err
+		}
+		if err := mctx.CheckAdminOr(ctx, export.UserID); err != nil {
+			return nil, err
+		}
+		if mctx.GetOpUserID(ctx) != export.UserID {
+			if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+				return nil, err
+			}
+		}
+		return &chat.GetDataExportResp{Export: DbToPbDataExport(export)}, nil
+	}
+	_, userID, err := o.dataExportUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	export, err := o.Database.TakeLatestDataExport(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return &chat.GetDataExportResp{}, nil
+		}
+		return nil, err
+	}
+	return &chat.GetDataExportResp{Export: DbToPbDataExport(export)}, nil
+}
+
+// DownloadDataExport checks the signed link and returns the export to download, chat-api reads the file from dataExport.dir.
+// The link needs no login.
+func (o *chatSvr) DownloadDataExport(ctx context.Context, req *chat.DownloadDataExportReq) (*chat.DownloadDataExportResp, error) {
+	defer log.ZDebug(ctx, "return")
+	exportID, err := dataexport.Parse(*config.Config.Secret, req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	export, err := o.Database.TakeDataExport(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.Status != constant.DataExportSuccess || export.ExpireTime.Before(time.Now()) {
+		return nil, errs.ErrArgs.Wrap("export is not available")
+	}
+	return &chat.DownloadDataExportResp{
+		FileName: "data_export_" + export.UserID + "_" + export.CreateTime.Format("20060102150405") + ".zip",
+		ExportID: export.ExportID,
+	}, nil
+}
+
+func (o *chatSvr) runDataExport(ctx context.Context, exportID string, userID string) {
+	status, errMsg := int32(constant.DataExportSuccess), ""
+	size, err := o.buildDataExport(ctx, exportID, userID)
+	if err != nil {
+		log.ZError(ctx, "data export failed", err, "exportID", exportID, "userID", userID)
+		status, errMsg = constant.DataExportFailed, err.Error()
+		if len(errMsg) > 255 {
+			errMsg = errMsg[:255]
+		}
+	}
+	if err := o.Database.FinishDataExport(ctx, exportID, status, errMsg, size); err != nil {
+		log.ZError(ctx, "finish data export failed", err, "exportID", exportID)
+	}
+}
+
+// buildDataExport gathers everything held about the user into the zip of the export and returns its size, the account is exported without the password.
+func (o *chatSvr) buildDataExport(ctx context.Context, exportID string, userID string) (int64, error) {
+	account, err := o.Database.GetAccount(ctx, userID)
+	if err != nil && !o.Database.IsNotFound(err) {
+		return 0, err
+	}
+	var accountData map[string]any
+	if err == nil {
+		accountData = map[string]any{
+			"userID":             account.UserID,
+			"createTime":         account.CreateTime,
+			"changeTime":         account.ChangeTime,
+			"operatorUserID":     account.OperatorUserID,
+			"mustChangePassword": account.MustChange,
+			"deletionTime":       account.DeletionTime,
+		}
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	register, err := o.Database.TakeRegister(ctx, userID)
+	if err != nil {
+		if !o.Database.IsNotFound(err) {
+			return 0, err
+		}
+		register = nil
+	}
+	var records []*chat2.UserLoginRecord
+	for pageNumber := int32(1); ; pageNumber++ {
+		_, page, err := o.Database.SearchLoginRecord(ctx, userID, "", "", "", nil, nil, pageNumber, dataExportPageSize)
+		if err != nil {
+			return 0, err
+		}
+		records = append(records, page...)
+		if len(page) < dataExportPageSize {
+			break
+		}
+	}
+	logs, err := o.Database.FindUserLogs(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	office, err := o.Office.ExportUserData(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	departments, err := o.Organization.GetUserDepartment(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return dataexport.Write(dataExportDir(), exportID, []dataexport.File{
+		{Name: "account.json", Data: accountData},
+		{Name: "attribute.json", Data: attribute},
+		{Name: "register.json", Data: register},
+		{Name: "login_records.json", Data: records},
+		{Name: "logs.json", Data: logs},
+		{Name: "tags.json", Data: office.Tags},
+		{Name: "tag_send_logs.json", Data: office.TagSendLogs},
+		{Name: "work_moments.json", Data: office.WorkMoments},
+		{Name: "departments.json", Data: departments},
+	}, time.Now())
+}
+
+func (o *chatSvr) dataExportPurgeLoop() {
+	for {
+		ctx := mcontext.NewCtx("data_export_purge_" + strconv.FormatInt(time.Now().UnixMilli(), 10))
+		var total int64
+		for {
+			count, err := o.Database.DelExpiredDataExport(ctx, time.Now(), dataExportPurgeBatch)
+			if err != nil {
+				log.ZError(ctx, "purge data export failed", err)
+				break
+			}
+			total += count
+			if count < dataExportPurgeBatch {
+				break
+			}
+		}
+		if total > 0 {
+			log.ZInfo(ctx, "purge data export", "count", total)
+		}
+		files, err := dataexport.RemoveExpired(dataExportDir(), time.Now().Add(-dataExportRetention()))
+		if err != nil {
+			log.ZError(ctx, "purge data export files failed", err)
+		} else if files > 0 {
+			log.ZInfo(ctx, "purge data export files", "count", files)
+		}
+		time.Sleep(dataExportPurgeInterval)
+	}
+}
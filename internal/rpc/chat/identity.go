@@ -0,0 +1,97 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/directory"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+func (o *chatSvr) FindExternalIdentity(ctx context.Context, req *chat.FindExternalIdentityReq) (*chat.FindExternalIdentityResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	identities, err := o.Database.FindExternalIdentity(ctx, req.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.FindExternalIdentityResp{Identities: utils.Slice(identities, DbToPbExternalIdentity)}, nil
+}
+
+func (o *chatSvr) AddExternalIdentity(ctx context.Context, req *chat.AddExternalIdentityReq) (*chat.AddExternalIdentityResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetUser(ctx, req.Identity.UserID); err != nil {
+		return nil, err
+	}
+	identity := &chat2.ExternalIdentity{
+		Provider: req.Identity.Provider,
+		Subject:  req.Identity.Subject,
+		UserID:   req.Identity.UserID,
+		Email:    req.Identity.Email,
+	}
+	if err := o.Database.CreateExternalIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+	return &chat.AddExternalIdentityResp{}, nil
+}
+
+// directoryIdentity returns the ldap identity of users synced from the directory, nil for other users.
+func (o *chatSvr) directoryIdentity(ctx context.Context, userID string) (*chat2.ExternalIdentity, error) {
+	if o.Directory == nil {
+		return nil, nil
+	}
+	identity, err := o.Database.TakeUserExternalIdentity(ctx, directory.Provider, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return identity, nil
+}
+
+// checkDirectoryPassword verifies the plaintext password by an ldap bind as the directory user.
+func (o *chatSvr) checkDirectoryPassword(ctx context.Context, attribute *chat2.Attribute, password string) error {
+	identity, err := o.directoryIdentity(ctx, attribute.UserID)
+	if err != nil {
+		return err
+	}
+	if identity == nil {
+		return eerrs.ErrPassword.Wrap("not a directory user")
+	}
+	user, err := o.Directory.Authenticate(attribute.Account, password)
+	if err != nil {
+		return err
+	}
+	if user.ID != identity.Subject {
+		return eerrs.ErrPassword.Wrap("directory user mismatch")
+	}
+	if err := o.Database.UpdateExternalIdentityLogin(ctx, identity.Provider, identity.Subject, user.Email); err != nil {
+		log.ZError(ctx, "update external identity failed", err, "userID", attribute.UserID)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+// checkIdentityFormat validates the new phone number or email and returns the area code with its + prefix.
+func checkIdentityFormat(areaCode string, phoneNumber string, email string) (string, error) {
+	if email != "" {
+		if err := chat.EmailCheck(email); err != nil {
+			return "", errs.ErrArgs.Wrap("email must be right")
+		}
+		return areaCode, nil
+	}
+	if areaCode == "" || phoneNumber == "" {
+		return "", errs.ErrArgs.Wrap("area code or phone number is empty")
+	}
+	if areaCode[0] != '+' {
+		areaCode = "+" + areaCode
+	}
+	if _, err := strconv.ParseUint(areaCode[1:], 10, 64); err != nil {
+		return "", errs.ErrArgs.Wrap("area code must be number")
+	}
+	if _, err := strconv.ParseUint(phoneNumber, 10, 64); err != nil {
+		return "", errs.ErrArgs.Wrap("phone number must be number")
+	}
+	return areaCode, nil
+}
+
+// checkChangeIdentityTarget is checked before the code of an identity change is sent, the old address is only used to confirm
+// the change and the new address must not be registered yet.
+func (o *chatSvr) checkChangeIdentityTarget(ctx context.Context, areaCode string, phoneNumber string, email string) (*chat2.Attribute, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if email == "" {
+		if attribute.AreaCode == areaCode && attribute.PhoneNumber == phoneNumber {
+			return attribute, nil
+		}
+		return attribute, o.checkPhoneUnused(ctx, areaCode, phoneNumber)
+	}
+	if attribute.Email == email {
+		return attribute, nil
+	}
+	return attribute, o.checkEmailUnused(ctx, email)
+}
+
+// ChangeIdentity changes the phone number or email used to log in, it needs the code sent to the new address
+// and, with changeIdentityVerifyOld enabled, the code sent to the old one.
+func (o *chatSvr) ChangeIdentity(ctx context.Context, req *chat.ChangeIdentityReq) (*chat.ChangeIdentityResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		account    string
+		oldAccount string
+		update     map[string]any
+	)
+	areaCode, err := checkIdentityFormat(req.AreaCode, req.PhoneNumber, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	req.AreaCode = areaCode
+	if req.Email == "" {
+		if attribute.AreaCode == req.AreaCode && attribute.PhoneNumber == req.PhoneNumber {
+			return nil, errs.ErrArgs.Wrap("phone number not changed")
+		}
+		if err := o.checkPhoneUnused(ctx, req.AreaCode, req.PhoneNumber); err != nil {
+			return nil, err
+		}
+		account = o.verifyCodeJoin(req.AreaCode, req.PhoneNumber)
+		if attribute.PhoneNumber != "" {
+			oldAccount = o.verifyCodeJoin(attribute.AreaCode, attribute.PhoneNumber)
+		}
+		update = map[string]any{"area_code": req.AreaCode, "phone_number": req.PhoneNumber}
+	} else {
+		if attribute.Email == req.Email {
+			return nil, errs.ErrArgs.Wrap("email not changed")
+		}
+		if err := o.checkEmailUnused(ctx, req.Email); err != nil {
+			return nil, err
+		}
+		account = req.Email
+		oldAccount = attribute.Email
+		update = map[string]any{"email": req.Email}
+	}
+	verifyCodeIDs := make([]uint, 0, 2)
+	id, err := o.verifyCode(ctx, account, req.VerifyCode)
+	if err != nil {
+		return nil, err
+	}
+	verifyCodeIDs = append(verifyCodeIDs, id)
+	if oldAccount != "" {
+		verifyOld, err := o.loginMethodEnabled(ctx, constant.ChangeIdentityVerifyOldConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		if verifyOld {
+			id, err := o.verifyCode(ctx, oldAccount, req.OldVerifyCode)
+			if err != nil {
+				return nil, err
+			}
+			verifyCodeIDs = append(verifyCodeIDs, id)
+		}
+	}
+	if err := o.Database.UpdateUseInfo(ctx, userID, update); err != nil {
+		return nil, err
+	}
+	for _, id := range verifyCodeIDs {
+		if id == 0 {
+			continue
+		}
+		if err := o.Database.DelVerifyCode(ctx, id); err != nil {
+			log.ZError(ctx, "del verify code failed", err, "id", id)
+		}
+	}
+	o.notifyIdentityChanged(ctx, attribute, req)
+	return &chat.ChangeIdentityResp{}, nil
+}
+
+// notifyIdentityChanged tells the old address it was replaced, a failure is only logged.
+func (o *chatSvr) notifyIdentityChanged(ctx context.Context, old *chat2.Attribute, req *chat.ChangeIdentityReq) {
+	if req.Email == "" {
+		if old.PhoneNumber == "" {
+			return
+		}
+		if err := o.SMS.SendPhoneChanged(ctx, old.AreaCode, old.PhoneNumber, maskPhone(req.PhoneNumber)); err != nil {
+			log.ZError(ctx, "send phone changed notification failed", err, "areaCode", old.AreaCode, "phoneNumber", old.PhoneNumber)
+		}
+		return
+	}
+	if old.Email == "" {
+		return
+	}
+	if err := o.Mail.SendEmailChanged(ctx, old.Email, mailLocale(ctx, old), maskEmail(req.Email), time.Now()); err != nil {
+		log.ZError(ctx, "send email changed notification failed", err, "email", old.Email)
+	}
+}
+
+// maskPhone keeps the first three and the last four digits.
+func maskPhone(phoneNumber string) string {
+	if len(phoneNumber) <= 7 {
+		return strings.Repeat("*", len(phoneNumber))
+	}
+	return phoneNumber[:3] + strings.Repeat("*", len(phoneNumber)-7) + phoneNumber[len(phoneNumber)-4:]
+}
+
+// maskEmail keeps the first character of the local part and the domain.
+func maskEmail(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i <= 0 {
+		return email
+	}
+	return email[:1] + "***" + email[i:]
+}
@@ -94,6 +94,9 @@ func (o *chatSvr) DeleteLogs(ctx context.Context, req *chat.DeleteLogsReq) (*cha
 		return nil, err
 	}
 	if userType == constant.AdminUser {
+		if err := o.Admin.CheckPermission(ctx, constant.PermissionLogs); err != nil {
+			return nil, err
+		}
 		userID = ""
 	}
 	logs, err := o.Database.GetLogs(ctx, req.LogIDs, userID)
@@ -119,6 +122,9 @@ func (o *chatSvr) SearchLogs(ctx context.Context, req *chat.SearchLogsReq) (*cha
 		resp    chat.SearchLogsResp
 		userIDs []string
 	)
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionLogs); err != nil {
+		return nil, err
+	}
 	if req.StartTime > req.EndTime {
 		return nil, errs.ErrArgs.Wrap("startTime>endTime")
 	}
@@ -34,7 +34,9 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
 	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 )
 
@@ -44,6 +46,7 @@ func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber string) string {
 
 func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeReq) (*chat.SendVerifyCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
+	var attribute *chat2.Attribute // the account the code is sent to, nil when registering
 	switch int(req.UsedFor) {
 	case constant.VerificationCodeForRegister:
 		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
@@ -62,20 +65,14 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 			if _, err := strconv.ParseUint(req.PhoneNumber, 10, 64); err != nil {
 				return nil, errs.ErrArgs.Wrap("phone number must be number")
 			}
-			_, err := o.Database.TakeAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
-			if err == nil {
-				return nil, eerrs.ErrPhoneAlreadyRegister.Wrap("phone already register")
-			} else if !o.Database.IsNotFound(err) {
+			if err := o.checkPhoneUnused(ctx, req.AreaCode, req.PhoneNumber); err != nil {
 				return nil, err
 			}
 		} else {
 			if err := chat.EmailCheck(req.Email); err != nil {
 				return nil, errs.ErrArgs.Wrap("email must be right")
 			}
-			_, err := o.Database.TakeAttributeByEmail(ctx, req.Email)
-			if err == nil {
-				return nil, eerrs.ErrEmailAlreadyRegister.Wrap("email already register")
-			} else if !o.Database.IsNotFound(err) {
+			if err := o.checkEmailUnused(ctx, req.Email); err != nil {
 				return nil, err
 			}
 		}
@@ -92,6 +89,11 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 			}
 		}
 	case constant.VerificationCodeForLogin, constant.VerificationCodeForResetPassword:
+		if req.UsedFor == constant.VerificationCodeForLogin {
+			if err := o.checkCodeLoginEnabled(ctx, req.Email != ""); err != nil {
+				return nil, err
+			}
+		}
 		if req.Email == "" {
 			_, err := o.Database.TakeAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
 			if o.Database.IsNotFound(err) {
@@ -100,17 +102,31 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 				return nil, err
 			}
 		} else {
-			_, err := o.Database.TakeAttributeByEmail(ctx, req.Email)
+			var err error
+			attribute, err = o.Database.TakeAttributeByEmail(ctx, req.Email)
 			if o.Database.IsNotFound(err) {
 				return nil, eerrs.ErrAccountNotFound.Wrap("email unregistered")
 			} else if err != nil {
 				return nil, err
 			}
 		}
+	case constant.VerificationCodeForChangeIdentity:
+		areaCode, err := checkIdentityFormat(req.AreaCode, req.PhoneNumber, req.Email)
+		if err != nil {
+			return nil, err
+		}
+		req.AreaCode = areaCode
+		attribute, err = o.checkChangeIdentityTarget(ctx, req.AreaCode, req.PhoneNumber, req.Email)
+		if err != nil {
+			return nil, err
+		}
 
 	default:
 		return nil, errs.ErrArgs.Wrap("used unknown")
 	}
+	if err := o.checkCaptcha(ctx, req); err != nil {
+		return nil, err
+	}
 	var account string
 	var isEmail bool
 	if req.Email == "" {
@@ -159,7 +175,7 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 	} else {
 		// 发送邮件验证码
 		err = o.Database.AddVerifyCode(ctx, t, func() error {
-			return o.Mail.SendMail(ctx, req.Email, t.Code)
+			return o.Mail.SendMail(ctx, req.Email, mailPurpose(req.UsedFor), mailLocale(ctx, attribute), t.Code)
 		})
 	}
 	if err != nil {
@@ -168,6 +184,28 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 	return &chat.SendVerifyCodeResp{}, nil
 }
 
+// checkPhoneUnused returns ErrPhoneAlreadyRegister when the phone number belongs to an account.
+func (o *chatSvr) checkPhoneUnused(ctx context.Context, areaCode string, phoneNumber string) error {
+	_, err := o.Database.TakeAttributeByPhone(ctx, areaCode, phoneNumber)
+	if err == nil {
+		return eerrs.ErrPhoneAlreadyRegister.Wrap("phone already register")
+	} else if !o.Database.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// checkEmailUnused returns ErrEmailAlreadyRegister when the email belongs to an account.
+func (o *chatSvr) checkEmailUnused(ctx context.Context, email string) error {
+	_, err := o.Database.TakeAttributeByEmail(ctx, email)
+	if err == nil {
+		return eerrs.ErrEmailAlreadyRegister.Wrap("email already register")
+	} else if !o.Database.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
 func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode string) (uint, error) {
 	defer log.ZDebug(ctx, "return")
 	if verifyCode == "" {
@@ -251,10 +289,15 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	resp := &chat.RegisterUserResp{}
 
 	isAdmin, err := o.Admin.CheckNilOrAdmin(ctx)
-	ctx = mctx.WithAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if isAdmin {
+		if err := o.Admin.CheckPermission(ctx, constant.PermissionUserCreate); err != nil {
+			return nil, err
+		}
+	}
+	ctx = mctx.WithAdminUser(ctx)
 	if req.User == nil {
 		return nil, errs.ErrArgs.Wrap("user is nil")
 	}
@@ -368,9 +411,13 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	hashedPassword, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chat2.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       hashedPassword,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -405,12 +452,17 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		}
 	}
 	if req.AutoLogin {
-		chatToken, adminErr := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		// the account exists now, a failed auto login leaves the user to log in normally.
+		loginResp, err := o.firstFactorPassed(ctx, req.User.UserID, req.Platform, req.DeviceID, req.Ip, nil)
 		if err != nil {
-			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
-		}
-		if adminErr == nil {
-			resp.ChatToken = chatToken.Token
+			log.ZError(ctx, "register auto login failed", err, "userID", req.User.UserID, "platform", req.Platform)
+		} else {
+			resp.ChatToken = loginResp.ChatToken
+			resp.RefreshToken = loginResp.RefreshToken
+			resp.TwoFactorChallenge = loginResp.TwoFactorChallenge
+			resp.TwoFactorSetup = loginResp.TwoFactorSetup
+			resp.RiskVerify = loginResp.RiskVerify
+			resp.PasswordChange = loginResp.PasswordChange
 		}
 	}
 	resp.UserID = req.User.UserID
@@ -419,12 +471,24 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 
 func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginResp, error) {
 	defer log.ZDebug(ctx, "return")
-	resp := &chat.LoginResp{}
-	if req.Password == "" && req.VerifyCode == "" {
+	if req.Password == "" && req.VerifyCode == "" && req.LdapPassword == "" {
 		return nil, errs.ErrArgs.Wrap("password or code must be set")
 	}
-	if req.Password == "" {
-		return nil, errs.ErrArgs.Wrap("password must be set")
+	if req.LdapPassword != "" && o.Directory == nil {
+		return nil, errs.ErrNoPermission.Wrap("ldap login disabled")
+	}
+	if req.Password == "" && req.LdapPassword == "" {
+		if req.Account != "" {
+			return nil, errs.ErrArgs.Wrap("password must be set")
+		}
+		if err := o.checkCodeLoginEnabled(ctx, req.Email != ""); err != nil {
+			return nil, err
+		}
+	}
+	if req.Ip != "" {
+		if err := loginlock.Check(ctx, o.Database, loginLockIP+req.Ip); err != nil {
+			return nil, err
+		}
 	}
 	var err error
 	var attribute *chat2.Attribute
@@ -448,6 +512,9 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	}
 	if err != nil {
 		if o.Database.IsNotFound(err) {
+			if err := o.loginFailed(ctx, nil, req.Ip); err != nil {
+				return nil, err
+			}
 			return nil, eerrs.ErrAccountNotFound.Wrap("user unregistered")
 		}
 		return nil, err
@@ -455,8 +522,11 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	if err := o.Admin.CheckLogin(ctx, attribute.UserID, req.Ip); err != nil {
 		return nil, err
 	}
+	if err := loginlock.Check(ctx, o.Database, loginLockUser+attribute.UserID); err != nil {
+		return nil, err
+	}
 	var verifyCodeID *uint
-	if req.Password == "" {
+	if req.Password == "" && req.LdapPassword == "" {
 		var id uint
 		var err error
 		if req.Email == "" {
@@ -466,38 +536,148 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 		}
 
 		if err != nil {
+			if lockErr := o.loginFailed(ctx, attribute, req.Ip); lockErr != nil {
+				return nil, lockErr
+			}
 			return nil, err
 		}
 		verifyCodeID = &id
+	} else if req.LdapPassword != "" {
+		if err := o.checkDirectoryPassword(ctx, attribute, req.LdapPassword); err != nil {
+			if lockErr := o.loginFailed(ctx, attribute, req.Ip); lockErr != nil {
+				return nil, lockErr
+			}
+			return nil, err
+		}
 	} else {
-		account, err := o.Database.GetAccount(ctx, attribute.UserID)
+		if err := o.loginPassword(ctx, attribute, req.Password); err != nil {
+			if lockErr := o.loginFailed(ctx, attribute, req.Ip); lockErr != nil {
+				return nil, lockErr
+			}
+			return nil, err
+		}
+	}
+	return o.firstFactorPassed(ctx, attribute.UserID, req.Platform, req.DeviceID, req.Ip, verifyCodeID)
+}
+
+// loginPassword checks the md5 password the client sends against the account.
+// Users synced from the directory have to log in with the plaintext ldapPassword instead.
+func (o *chatSvr) loginPassword(ctx context.Context, attribute *chat2.Attribute, password string) error {
+	identity, err := o.directoryIdentity(ctx, attribute.UserID)
+	if err != nil {
+		return err
+	}
+	if identity != nil {
+		return errs.ErrArgs.Wrap("directory users log in with ldapPassword")
+	}
+	account, err := o.Database.GetAccount(ctx, attribute.UserID)
+	if err != nil {
+		return err
+	}
+	return o.checkPassword(ctx, account, password)
+}
+
+// checkCodeLoginEnabled fails unless logging in by the sms or email verify code is switched on.
+func (o *chatSvr) checkCodeLoginEnabled(ctx context.Context, isEmail bool) error {
+	key := constant.LoginBySmsCodeConfigKey
+	if isEmail {
+		key = constant.LoginByEmailCodeConfigKey
+	}
+	enabled, err := o.loginMethodEnabled(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errs.ErrNoPermission.Wrap("login by verify code disabled")
+	}
+	return nil
+}
+
+// loginMethodEnabled reads a passwordless login switch from the client config, all of them default to off.
+func (o *chatSvr) loginMethodEnabled(ctx context.Context, key string) (bool, error) {
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	return utils.Contain(strings.ToLower(conf[key]), "1", "true", "yes"), nil
+}
+
+// firstFactorPassed either starts the two-factor challenge or completes the login.
+func (o *chatSvr) firstFactorPassed(ctx context.Context, userID string, platform int32, deviceID string, ip string, verifyCodeID *uint) (*chat.LoginResp, error) {
+	resp := &chat.LoginResp{UserID: userID}
+	risk, err := o.checkLoginRisk(ctx, userID, deviceID, ip)
+	if err != nil {
+		return nil, err
+	}
+	if risk != nil && loginRiskPolicy() == loginRiskNotify {
+		o.notifyLoginRisk(ctx, userID, ip, risk)
+	}
+	challenge, setup, err := o.twoFactorChallenge(ctx, userID, platform, deviceID, ip)
+	if err != nil {
+		return nil, err
+	}
+	// a second factor already covers an unusual login
+	if challenge == "" && risk != nil && loginRiskPolicy() == loginRiskVerify {
+		challenge, err = o.loginRiskChallenge(ctx, userID, platform, deviceID, ip)
 		if err != nil {
 			return nil, err
 		}
-		if account.Password != req.Password {
-			return nil, eerrs.ErrPassword.Wrap()
+		resp.RiskVerify = challenge != ""
+	}
+	if challenge != "" {
+		if verifyCodeID != nil {
+			if err := o.Database.DelVerifyCode(ctx, *verifyCodeID); err != nil {
+				return nil, err
+			}
 		}
+		resp.TwoFactorChallenge = challenge
+		resp.TwoFactorSetup = setup
+		return resp, nil
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, attribute.UserID, constant.NormalUser)
+	resp.ChatToken, resp.RefreshToken, resp.PasswordChange, err = o.loginSuccess(ctx, userID, platform, deviceID, ip, verifyCodeID)
 	if err != nil {
 		return nil, err
 	}
+	return resp, nil
+}
+
+// loginSuccess issues the chat and refresh tokens and writes the login record once every factor has been verified.
+// While the password has to be changed only a token limited to changing it is issued, passwordChange is true then.
+func (o *chatSvr) loginSuccess(ctx context.Context, userID string, platform int32, deviceID string, ip string, verifyCodeID *uint) (string, string, bool, error) {
+	if err := o.Database.DelLoginFail(ctx, loginLockUser+userID); err != nil {
+		log.ZError(ctx, "reset login failures failed", err, "userID", userID)
+	}
+	if err := o.cancelAccountDeletion(ctx, userID); err != nil {
+		return "", "", false, err
+	}
+	passwordChange, err := o.needChangePassword(ctx, userID)
+	if err != nil {
+		return "", "", false, err
+	}
+	var resp *admin.CreateTokenResp
+	if passwordChange {
+		resp, err = o.Admin.CreateScopeToken(ctx, userID, constant.NormalUser, platform, constant.TokenScopePassword)
+	} else {
+		resp, err = o.Admin.CreateToken(ctx, userID, constant.NormalUser, platform, deviceID, ip)
+	}
+	if err != nil {
+		return "", "", false, err
+	}
 	record := &chat2.UserLoginRecord{
-		UserID:    attribute.UserID,
+		UserID:    userID,
 		LoginTime: time.Now(),
-		IP:        req.Ip,
-		DeviceID:  req.DeviceID,
-		Platform:  constant2.PlatformIDToName(int(req.Platform)),
+		IP:        ip,
+		DeviceID:  deviceID,
+		Platform:  constant2.PlatformIDToName(int(platform)),
+		Location:  o.loginLocation(ip),
 	}
 	if err := o.Database.LoginRecord(ctx, record, verifyCodeID); err != nil {
-		return nil, err
+		return "", "", false, err
 	}
 	if verifyCodeID != nil {
 		if err := o.Database.DelVerifyCode(ctx, *verifyCodeID); err != nil {
-			return nil, err
+			return "", "", false, err
 		}
 	}
-	resp.UserID = attribute.UserID
-	resp.ChatToken = chatToken.Token
-	return resp, nil
+	return resp.Token, resp.RefreshToken, passwordChange, nil
 }
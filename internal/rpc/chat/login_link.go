@@ -0,0 +1,151 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlink"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+func loginLinkExpire() time.Duration {
+	if config.Config.LoginLink.Expire <= 0 {
+		return time.Minute * 10
+	}
+	return time.Duration(config.Config.LoginLink.Expire) * time.Second
+}
+
+func (o *chatSvr) checkLinkLoginEnabled(ctx context.Context) error {
+	enabled, err := o.loginMethodEnabled(ctx, constant.LoginByMagicLinkConfigKey)
+	if err != nil {
+		return err
+	}
+	if !enabled || config.Config.LoginLink.URL == "" {
+		return errs.ErrNoPermission.Wrap("login by link disabled")
+	}
+	return nil
+}
+
+// SendLoginLink mails a login link, it succeeds for unregistered emails as well so it does not tell which are registered.
+func (o *chatSvr) SendLoginLink(ctx context.Context, req *chat.SendLoginLinkReq) (*chat.SendLoginLinkResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkLinkLoginEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if req.Ip != "" {
+		if err := loginlock.Check(ctx, o.Database, loginLockIP+req.Ip); err != nil {
+			return nil, err
+		}
+	}
+	if req.Email == "" {
+		return nil, errs.ErrArgs.Wrap("email is empty")
+	}
+	captchaReq := &chat.SendVerifyCodeReq{
+		Ip:            req.Ip,
+		DeviceID:      req.DeviceID,
+		Email:         req.Email,
+		CaptchaID:     req.CaptchaID,
+		CaptchaAnswer: req.CaptchaAnswer,
+	}
+	if err := o.checkCaptcha(ctx, captchaReq); err != nil {
+		return nil, err
+	}
+	verifyCode := config.Config.VerifyCode
+	if verifyCode.UintTime > 0 && verifyCode.MaxCount > 0 {
+		count, err := o.Database.IncrLoginLinkSend(ctx, req.Email, time.Duration(verifyCode.UintTime)*time.Second)
+		if err != nil {
+			return nil, err
+		}
+		if count > int64(verifyCode.MaxCount) {
+			return nil, eerrs.ErrVerifyCodeSendFrequently.Wrap("login link send frequently")
+		}
+	}
+	attribute, err := o.Database.GetAttributeByEmail(ctx, req.Email)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			log.ZInfo(ctx, "login link for unregistered email", "email", req.Email)
+			return &chat.SendLoginLinkResp{}, nil
+		}
+		return nil, err
+	}
+	nonce, err := loginlink.GenerateNonce()
+	if err != nil {
+		return nil, err
+	}
+	expire := loginLinkExpire()
+	if err := o.Database.SetLoginLink(ctx, nonce, attribute.UserID, expire); err != nil {
+		return nil, err
+	}
+	token := loginlink.Sign(*config.Config.Secret, attribute.UserID, nonce, time.Now().Add(expire))
+	link := config.Config.LoginLink.URL
+	if strings.Contains(link, "?") {
+		link += "&"
+	} else {
+		link += "?"
+	}
+	link += "token=" + url.QueryEscape(token)
+	if err := o.Mail.SendLoginLink(ctx, req.Email, mailLocale(ctx, attribute), link, expire); err != nil {
+		return nil, err
+	}
+	return &chat.SendLoginLinkResp{}, nil
+}
+
+func (o *chatSvr) LoginByLink(ctx context.Context, req *chat.LoginByLinkReq) (*chat.LoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkLinkLoginEnabled(ctx); err != nil {
+		return nil, err
+	}
+	if req.Ip != "" {
+		if err := loginlock.Check(ctx, o.Database, loginLockIP+req.Ip); err != nil {
+			return nil, err
+		}
+	}
+	userID, nonce, err := loginlink.Parse(*config.Config.Secret, req.Token, time.Now())
+	if err != nil {
+		if lockErr := o.loginFailed(ctx, nil, req.Ip); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+	linkUserID, err := o.Database.TakeLoginLink(ctx, nonce)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, eerrs.ErrLoginLinkInvalid.Wrap("link used")
+		}
+		return nil, err
+	}
+	if linkUserID != userID {
+		return nil, eerrs.ErrLoginLinkInvalid.Wrap("user mismatch")
+	}
+	if err := o.Admin.CheckLogin(ctx, userID, req.Ip); err != nil {
+		return nil, err
+	}
+	if err := loginlock.Check(ctx, o.Database, loginLockUser+userID); err != nil {
+		return nil, err
+	}
+	return o.firstFactorPassed(ctx, userID, req.Platform, req.DeviceID, req.Ip, nil)
+}
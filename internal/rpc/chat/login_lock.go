@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	loginLockUser = "user:"
+	loginLockIP   = "ip:"
+)
+
+// loginFailed counts a wrong password, attribute is nil if the account does not exist.
+// It returns an error once the account or the ip gets locked.
+func (o *chatSvr) loginFailed(ctx context.Context, attribute *chat2.Attribute, ip string) error {
+	if ip != "" {
+		lockTime, err := loginlock.AddFail(ctx, o.Database, loginLockIP+ip, config.Config.LoginLock.IPMaxAttempts)
+		if err != nil {
+			return err
+		}
+		if lockTime > 0 {
+			log.ZWarn(ctx, "login ip locked", nil, "ip", ip, "lockTime", lockTime)
+		}
+	}
+	if attribute == nil {
+		return nil
+	}
+	lockTime, err := loginlock.AddFail(ctx, o.Database, loginLockUser+attribute.UserID, config.Config.LoginLock.MaxAttempts)
+	if err != nil {
+		return err
+	}
+	if lockTime == 0 {
+		return nil
+	}
+	log.ZWarn(ctx, "login account locked", nil, "userID", attribute.UserID, "lockTime", lockTime)
+	if attribute.PhoneNumber != "" {
+		if err := o.SMS.SendAccountLocked(ctx, attribute.AreaCode, attribute.PhoneNumber, lockTime); err != nil {
+			log.ZError(ctx, "send account locked sms failed", err, "userID", attribute.UserID)
+		}
+	} else if attribute.Email != "" {
+		if err := o.Mail.SendAccountLocked(ctx, attribute.Email, mailLocale(ctx, attribute), lockTime); err != nil {
+			log.ZError(ctx, "send account locked mail failed", err, "userID", attribute.UserID)
+		}
+	}
+	return loginlock.Locked(lockTime)
+}
+
+func (o *chatSvr) UnlockLogin(ctx context.Context, req *chat.UnlockLoginReq) (*chat.UnlockLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(req.UserIDs)+len(req.Ips))
+	for _, userID := range req.UserIDs {
+		keys = append(keys, loginLockUser+userID)
+	}
+	for _, ip := range req.Ips {
+		keys = append(keys, loginLockIP+ip)
+	}
+	if err := o.Database.UnlockLogin(ctx, keys); err != nil {
+		return nil, err
+	}
+	return &chat.UnlockLoginResp{}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	loginRecordPurgeInterval = time.Hour
+	loginRecordPurgeBatch    = 1000
+)
+
+func unixMilliOrNil(ms int64) *time.Time {
+	if ms == 0 {
+		return nil
+	}
+	t := time.UnixMilli(ms)
+	return &t
+}
+
+func (o *chatSvr) SearchUserLoginRecord(ctx context.Context, req *chat.SearchUserLoginRecordReq) (*chat.SearchUserLoginRecordResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userType == constant.AdminUser {
+		if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+			return nil, err
+		}
+	} else {
+		req.UserID = opUserID
+	}
+	total, records, err := o.Database.SearchLoginRecord(ctx, req.UserID, req.Platform, req.Ip, req.DeviceID, unixMilliOrNil(req.StartTime), unixMilliOrNil(req.EndTime), req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		userIDs = append(userIDs, record.UserID)
+	}
+	attributes, err := o.Database.FindAttribute(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	nicknames := make(map[string]string)
+	for _, attribute := range attributes {
+		nicknames[attribute.UserID] = attribute.Nickname
+	}
+	resp := &chat.SearchUserLoginRecordResp{Total: total, Records: make([]*chat.UserLoginRecord, 0, len(records))}
+	for _, record := range records {
+		resp.Records = append(resp.Records, DbToPbUserLoginRecord(record, nicknames[record.UserID]))
+	}
+	return resp, nil
+}
+
+func DbToPbUserLoginRecord(record *chat2.UserLoginRecord, nickname string) *chat.UserLoginRecord {
+	return &chat.UserLoginRecord{
+		UserID:    record.UserID,
+		LoginTime: record.LoginTime.UnixMilli(),
+		Ip:        record.IP,
+		DeviceID:  record.DeviceID,
+		Platform:  record.Platform,
+		Location:  record.Location,
+		Nickname:  nickname,
+	}
+}
+
+func (o *chatSvr) loginRecordPurgeLoop() {
+	for {
+		if config.Config.LoginRecord.Retention > 0 {
+			ctx := mcontext.NewCtx("login_record_purge_" + strconv.FormatInt(time.Now().UnixMilli(), 10))
+			o.purgeLoginRecord(ctx, time.Now().AddDate(0, 0, -config.Config.LoginRecord.Retention))
+		}
+		time.Sleep(loginRecordPurgeInterval)
+	}
+}
+
+// purgeLoginRecord deletes the records older than before in batches to keep the locks short.
+func (o *chatSvr) purgeLoginRecord(ctx context.Context, before time.Time) {
+	var total int64
+	for {
+		count, err := o.Database.DelLoginRecordBefore(ctx, before, loginRecordPurgeBatch)
+		if err != nil {
+			log.ZError(ctx, "purge login record failed", err, "before", before)
+			return
+		}
+		total += count
+		if count < loginRecordPurgeBatch {
+			break
+		}
+	}
+	if total > 0 {
+		log.ZInfo(ctx, "purge login record", "before", before, "count", total)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/common/geoip"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/email"
+)
+
+const (
+	loginRiskNotify = "notify"
+	loginRiskVerify = "verify"
+
+	loginRiskIPHistory = 1000
+)
+
+// loginRisk describes why a login looks unusual.
+type loginRisk struct {
+	NewDevice  bool
+	NewNetwork bool
+	Location   string
+}
+
+func newGeoIP() (*geoip.DB, error) {
+	if config.Config.LoginRisk.GeoIP == "" {
+		return nil, nil
+	}
+	return geoip.Open(config.Config.LoginRisk.GeoIP)
+}
+
+func loginRiskPolicy() string {
+	return strings.ToLower(config.Config.LoginRisk.Policy)
+}
+
+func loginNetwork(ip string) (string, error) {
+	bits4, bits6 := config.Config.LoginRisk.IPv4Prefix, config.Config.LoginRisk.IPv6Prefix
+	if bits4 <= 0 {
+		bits4 = 24
+	}
+	if bits6 <= 0 {
+		bits6 = 48
+	}
+	return iprule.Network(ip, bits4, bits6)
+}
+
+func (o *chatSvr) loginLocation(ip string) string {
+	location, _ := o.GeoIP.Lookup(ip)
+	return location.String()
+}
+
+// checkLoginRisk returns nil unless the device or the ip network has never been used by the user,
+// the first login of a user is never unusual.
+func (o *chatSvr) checkLoginRisk(ctx context.Context, userID string, deviceID string, ip string) (*loginRisk, error) {
+	if loginRiskPolicy() == "" {
+		return nil, nil
+	}
+	ips, err := o.Database.FindLoginIP(ctx, userID, loginRiskIPHistory)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, nil
+	}
+	var risk loginRisk
+	if deviceID != "" {
+		exist, err := o.Database.ExistLoginDevice(ctx, userID, deviceID)
+		if err != nil {
+			return nil, err
+		}
+		risk.NewDevice = !exist
+	}
+	if network, err := loginNetwork(ip); err == nil {
+		risk.NewNetwork = true
+		for _, s := range ips {
+			if n, err := loginNetwork(s); err == nil && n == network {
+				risk.NewNetwork = false
+				break
+			}
+		}
+	}
+	if !(risk.NewDevice || risk.NewNetwork) {
+		return nil, nil
+	}
+	risk.Location = o.loginLocation(ip)
+	log.ZInfo(ctx, "unusual login", "userID", userID, "deviceID", deviceID, "ip", ip, "newDevice", risk.NewDevice, "newNetwork", risk.NewNetwork, "location", risk.Location)
+	return &risk, nil
+}
+
+// notifyLoginRisk alerts the user by sms or email, failures are only logged.
+func (o *chatSvr) notifyLoginRisk(ctx context.Context, userID string, ip string, risk *loginRisk) {
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		log.ZError(ctx, "get attribute failed", err, "userID", userID)
+		return
+	}
+	if attribute.PhoneNumber != "" {
+		if err := o.SMS.SendLoginAlert(ctx, attribute.AreaCode, attribute.PhoneNumber, ip, risk.Location); err != nil {
+			log.ZError(ctx, "send login alert sms failed", err, "userID", userID)
+		}
+	} else if attribute.Email != "" {
+		if err := o.Mail.SendLoginAlert(ctx, attribute.Email, mailLocale(ctx, attribute), ip, risk.Location, time.Now()); err != nil {
+			log.ZError(ctx, "send login alert mail failed", err, "userID", userID)
+		}
+	}
+}
+
+func hashLoginRiskCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// loginRiskChallenge sends a verification code to the user and returns the challenge it completes,
+// the challenge is empty when the user has neither a phone number nor an email to send it to.
+func (o *chatSvr) loginRiskChallenge(ctx context.Context, userID string, platform int32, deviceID string, ip string) (string, error) {
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if attribute.PhoneNumber == "" && attribute.Email == "" {
+		log.ZWarn(ctx, "unusual login can not be verified", nil, "userID", userID)
+		return "", nil
+	}
+	verifyCode := config.Config.VerifyCode
+	var code string
+	if verifyCode.Use == "" {
+		if verifyCode.SuperCode == "" {
+			return "", errs.ErrInternalServer.Wrap("super code is empty")
+		}
+		code = verifyCode.SuperCode
+	} else {
+		code = o.genVerifyCode()
+	}
+	challenge, err := o.genChallenge()
+	if err != nil {
+		return "", err
+	}
+	value := &cache.LoginChallenge{
+		UserID:   userID,
+		UserType: constant.NormalUser,
+		Platform: platform,
+		DeviceID: deviceID,
+		IP:       ip,
+		Code:     hashLoginRiskCode(code),
+	}
+	if err := o.Database.SetLoginChallenge(ctx, challenge, value, twoFactorChallengeExpire); err != nil {
+		return "", err
+	}
+	if verifyCode.Use != "" {
+		if attribute.PhoneNumber != "" {
+			err = o.SMS.SendCode(ctx, attribute.AreaCode, attribute.PhoneNumber, code)
+		} else {
+			err = o.Mail.SendMail(ctx, attribute.Email, email.PurposeLogin, mailLocale(ctx, attribute), code)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return challenge, nil
+}
+
+func checkLoginRiskCode(value *cache.LoginChallenge, code string) error {
+	if code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	if subtle.ConstantTimeCompare([]byte(hashLoginRiskCode(code)), []byte(value.Code)) != 1 {
+		return eerrs.ErrVerifyCodeNotMatch.Wrap()
+	}
+	return nil
+}
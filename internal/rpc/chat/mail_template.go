@@ -0,0 +1,141 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/email"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+// mailPurpose is the mail template of a verify code.
+func mailPurpose(usedFor int32) string {
+	switch usedFor {
+	case constant.VerificationCodeForRegister:
+		return email.PurposeRegister
+	case constant.VerificationCodeForResetPassword:
+		return email.PurposeReset
+	case constant.VerificationCodeForChangeIdentity:
+		return email.PurposeChangeIdentity
+	default:
+		return email.PurposeLogin
+	}
+}
+
+// mailLocale prefers the language set by the user, then the Accept-Language of the request.
+func mailLocale(ctx context.Context, attribute *chat2.Attribute) string {
+	if attribute != nil && attribute.Language != "" {
+		return attribute.Language
+	}
+	return mctx.GetLanguage(ctx)
+}
+
+func (o *chatSvr) GetMailTemplates(ctx context.Context, req *chat.GetMailTemplatesReq) (*chat.GetMailTemplatesResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	purposes := email.Purposes()
+	if req.Purpose != "" {
+		if !utils.Contain(req.Purpose, purposes...) {
+			return nil, errs.ErrArgs.Wrap("unknown purpose " + req.Purpose)
+		}
+		purposes = []string{req.Purpose}
+	}
+	var templates []*chat.MailTemplate
+	for _, purpose := range purposes {
+		custom, err := o.Database.FindMailTemplate(ctx, purpose)
+		if err != nil {
+			return nil, err
+		}
+		locales := make(map[string]*chat.MailTemplate)
+		for locale, t := range email.Builtin(purpose) {
+			locales[locale] = &chat.MailTemplate{Purpose: purpose, Locale: locale, Subject: t.Subject, Html: t.HTML, Text: t.Text}
+		}
+		for _, t := range custom {
+			locales[t.Locale] = &chat.MailTemplate{
+				Purpose:    t.Purpose,
+				Locale:     t.Locale,
+				Subject:    t.Subject,
+				Html:       t.HTML,
+				Text:       t.Text,
+				Custom:     true,
+				UpdateTime: t.UpdateTime.UnixMilli(),
+			}
+		}
+		keys := make([]string, 0, len(locales))
+		for locale := range locales {
+			keys = append(keys, locale)
+		}
+		sort.Strings(keys)
+		for _, locale := range keys {
+			templates = append(templates, locales[locale])
+		}
+	}
+	return &chat.GetMailTemplatesResp{Templates: templates}, nil
+}
+
+// SetMailTemplate overrides the built-in template of the purpose and locale, or adds a locale.
+func (o *chatSvr) SetMailTemplate(ctx context.Context, req *chat.SetMailTemplateReq) (*chat.SetMailTemplateResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.Contain(req.Template.Purpose, email.Purposes()...) {
+		return nil, errs.ErrArgs.Wrap("unknown purpose " + req.Template.Purpose)
+	}
+	if len(req.Template.Locale) > 16 {
+		return nil, errs.ErrArgs.Wrap("locale is too long")
+	}
+	if err := email.Validate(&email.Template{Subject: req.Template.Subject, HTML: req.Template.Html, Text: req.Template.Text}); err != nil {
+		return nil, err
+	}
+	err = o.Database.SetMailTemplate(ctx, &chat2.MailTemplate{
+		Purpose:        req.Template.Purpose,
+		Locale:         req.Template.Locale,
+		Subject:        req.Template.Subject,
+		HTML:           req.Template.Html,
+		Text:           req.Template.Text,
+		OperatorUserID: opUserID,
+		UpdateTime:     time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &chat.SetMailTemplateResp{}, nil
+}
+
+// DelMailTemplate goes back to the built-in template.
+func (o *chatSvr) DelMailTemplate(ctx context.Context, req *chat.DelMailTemplateReq) (*chat.DelMailTemplateResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelMailTemplate(ctx, req.Purpose, req.Locale); err != nil {
+		return nil, err
+	}
+	return &chat.DelMailTemplateResp{}, nil
+}
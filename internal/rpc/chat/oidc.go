@@ -0,0 +1,242 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/oidc"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+func newOIDCProviders() map[string]*oidc.Provider {
+	providers := make(map[string]*oidc.Provider)
+	for _, p := range config.Config.OIDC.Providers {
+		providers[p.Name] = oidc.NewProvider(oidc.Config{
+			Name:         p.Name,
+			Issuer:       p.Issuer,
+			ClientID:     p.ClientID,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		})
+	}
+	return providers
+}
+
+func oidcProviderConfig(name string) *config.OIDCProvider {
+	for i, p := range config.Config.OIDC.Providers {
+		if p.Name == name {
+			return &config.Config.OIDC.Providers[i]
+		}
+	}
+	return nil
+}
+
+func oidcStateExpire() time.Duration {
+	if config.Config.OIDC.StateExpire <= 0 {
+		return time.Minute * 10
+	}
+	return time.Duration(config.Config.OIDC.StateExpire) * time.Second
+}
+
+func (o *chatSvr) GetOIDCAuthURL(ctx context.Context, req *chat.GetOIDCAuthURLReq) (*chat.GetOIDCAuthURLResp, error) {
+	defer log.ZDebug(ctx, "return")
+	provider, ok := o.OIDC[req.Provider]
+	if !ok {
+		return nil, errs.ErrArgs.Wrap("unknown provider " + req.Provider)
+	}
+	state, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	authURL, err := provider.AuthURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetOIDCState(ctx, state, &cache.OIDCState{Provider: req.Provider, Verifier: verifier, Nonce: nonce}, oidcStateExpire()); err != nil {
+		return nil, err
+	}
+	return &chat.GetOIDCAuthURLResp{AuthURL: authURL, State: state}, nil
+}
+
+func (o *chatSvr) LoginByOIDC(ctx context.Context, req *chat.LoginByOIDCReq) (*chat.LoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if req.Ip != "" {
+		if err := loginlock.Check(ctx, o.Database, loginLockIP+req.Ip); err != nil {
+			return nil, err
+		}
+	}
+	state, err := o.Database.TakeOIDCState(ctx, req.State)
+	if err != nil {
+		if errs.Unwrap(err) == redis.Nil {
+			return nil, eerrs.ErrIdentityInvalid.Wrap("state expired")
+		}
+		return nil, err
+	}
+	provider, ok := o.OIDC[state.Provider]
+	if !ok {
+		return nil, errs.ErrArgs.Wrap("unknown provider " + state.Provider)
+	}
+	claims, err := provider.Exchange(ctx, req.Code, state.Verifier, state.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := o.identityUserID(ctx, state.Provider, claims, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Admin.CheckLogin(ctx, userID, req.Ip); err != nil {
+		return nil, err
+	}
+	if err := loginlock.Check(ctx, o.Database, loginLockUser+userID); err != nil {
+		return nil, err
+	}
+	return o.firstFactorPassed(ctx, userID, req.Platform, req.DeviceID, req.Ip, nil)
+}
+
+// identityUserID maps the identity onto a user, binding it by verified email or registering the user on first login when the provider allows it.
+func (o *chatSvr) identityUserID(ctx context.Context, providerName string, claims *oidc.Claims, req *chat.LoginByOIDCReq) (string, error) {
+	var email string
+	if claims.EmailVerified {
+		email = claims.Email
+	}
+	identity, err := o.Database.TakeExternalIdentity(ctx, providerName, claims.Subject)
+	if err == nil {
+		if err := o.Database.UpdateExternalIdentityLogin(ctx, providerName, claims.Subject, email); err != nil {
+			log.ZError(ctx, "update external identity failed", err, "provider", providerName, "subject", claims.Subject)
+		}
+		return identity.UserID, nil
+	} else if !o.Database.IsNotFound(err) {
+		return "", err
+	}
+	conf := oidcProviderConfig(providerName)
+	if conf == nil {
+		return "", errs.ErrArgs.Wrap("unknown provider " + providerName)
+	}
+	var userID string
+	if conf.LinkByEmail && email != "" {
+		attribute, err := o.Database.TakeAttributeByEmail(ctx, email)
+		if err == nil {
+			userID = attribute.UserID
+		} else if !o.Database.IsNotFound(err) {
+			return "", err
+		}
+	}
+	if userID == "" {
+		if !conf.AutoRegister {
+			return "", eerrs.ErrAccountNotFound.Wrap("identity not bound")
+		}
+		return o.registerIdentityUser(ctx, providerName, claims, email, req)
+	}
+	if err := o.bindIdentity(ctx, providerName, claims.Subject, userID, email); err != nil {
+		return "", err
+	}
+	return userID, nil
+}
+
+func (o *chatSvr) bindIdentity(ctx context.Context, providerName string, subject string, userID string, email string) error {
+	return o.Database.CreateExternalIdentity(ctx, &chat2.ExternalIdentity{
+		Provider:      providerName,
+		Subject:       subject,
+		UserID:        userID,
+		Email:         email,
+		LastLoginTime: time.Now(),
+	})
+}
+
+// registerIdentityUser registers the user of a new identity the same way the register api does and binds the identity to it.
+// If binding or the im registration fails the user is deleted again, so the next login registers it from scratch.
+func (o *chatSvr) registerIdentityUser(ctx context.Context, providerName string, claims *oidc.Claims, email string, req *chat.LoginByOIDCReq) (string, error) {
+	nickname := claims.Name
+	if nickname == "" {
+		nickname = claims.PreferredUsername
+	}
+	if nickname == "" && email != "" {
+		nickname, _, _ = strings.Cut(email, "@")
+	}
+	if nickname == "" {
+		nickname = claims.Subject
+	}
+	resp, err := o.RegisterUser(mctx.WithAdminUser(ctx), &chat.RegisterUserReq{
+		Ip:       req.Ip,
+		DeviceID: req.DeviceID,
+		Platform: req.Platform,
+		User: &chat.RegisterUserInfo{
+			Nickname: nickname,
+			FaceURL:  claims.Picture,
+			Email:    email,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	userInfo := &sdkws.UserInfo{
+		UserID:     resp.UserID,
+		Nickname:   nickname,
+		FaceURL:    claims.Picture,
+		CreateTime: time.Now().UnixMilli(),
+	}
+	if err := o.bindIdentity(ctx, providerName, claims.Subject, resp.UserID, email); err != nil {
+		o.rollbackIdentityUser(ctx, resp.UserID)
+		return "", err
+	}
+	if err := o.imApiCaller.RegisterUser(ctx, []*sdkws.UserInfo{userInfo}); err != nil {
+		o.rollbackIdentityUser(ctx, resp.UserID)
+		return "", err
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		log.ZError(ctx, "get im admin token failed", err, "userID", resp.UserID)
+		return resp.UserID, nil
+	}
+	apiCtx := mctx.WithApiToken(ctx, imToken)
+	rpcCtx := mctx.WithAdminUser(ctx)
+	if userIDs, err := o.Admin.GetDefaultFriendUserID(rpcCtx); err == nil {
+		_ = o.imApiCaller.ImportFriend(apiCtx, resp.UserID, userIDs)
+	}
+	if groupIDs, err := o.Admin.GetDefaultGroupID(rpcCtx); err == nil {
+		_ = o.imApiCaller.InviteToGroup(apiCtx, resp.UserID, groupIDs)
+	}
+	return resp.UserID, nil
+}
+
+// rollbackIdentityUser deletes a user whose registration by an identity did not complete, with the identity bound to it.
+func (o *chatSvr) rollbackIdentityUser(ctx context.Context, userID string) {
+	if err := o.Database.DeleteUser(ctx, []string{userID}); err != nil {
+		log.ZError(ctx, "delete incomplete identity user failed", err, "userID", userID)
+	}
+}
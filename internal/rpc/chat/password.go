@@ -16,14 +16,22 @@ package chat
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/OpenIMSDK/chat/pkg/common/config"
 	constant2 "github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/OpenIMSDK/tools/errs"
 
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/password"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 )
 
@@ -32,8 +40,10 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	if req.Password == "" {
 		return nil, errs.ErrArgs.Wrap("password must be set")
 	}
-	var verifyCodeID uint
-	var err error
+	var (
+		verifyCodeID uint
+		err          error
+	)
 	if req.Email == "" {
 		verifyCodeID, err = o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode)
 	} else {
@@ -49,13 +59,18 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 		if err != nil {
 			return nil, err
 		}
-		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, req.Password, verifyCodeID)
+		err = o.changePassword(ctx, attribute.UserID, req.Password, false, &verifyCodeID)
+		if err == nil {
+			if err := o.SMS.SendPasswordReset(ctx, req.AreaCode, req.PhoneNumber); err != nil {
+				log.ZError(ctx, "send password reset notice failed", err, "userID", attribute.UserID)
+			}
+		}
 	} else {
 		attribute, err := o.Database.GetAttributeByEmail(ctx, req.Email)
 		if err != nil {
 			return nil, err
 		}
-		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, req.Password, verifyCodeID)
+		err = o.changePassword(ctx, attribute.UserID, req.Password, false, &verifyCodeID)
 	}
 
 	if err != nil {
@@ -88,6 +103,9 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		if req.UserID == "" {
 			return nil, errs.ErrArgs.Wrap("user id must be set")
 		}
+		if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errs.ErrInternalServer.Wrap("invalid user type")
 	}
@@ -96,14 +114,12 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		return nil, err
 	}
 	if userType != constant.AdminUser {
-		if user.Password != req.CurrentPassword {
+		if match, _ := password.Verify(user.Password, req.CurrentPassword); !match {
 			return nil, errs.ErrNoPermission.Wrap("current password is wrong")
 		}
 	}
-	if user.Password != req.NewPassword {
-		if err := o.Database.UpdatePassword(ctx, req.UserID, req.NewPassword); err != nil {
-			return nil, err
-		}
+	if err := o.changePassword(ctx, req.UserID, req.NewPassword, userType == constant.AdminUser && req.MustChange, nil); err != nil {
+		return nil, err
 	}
 
 	imToken, err := o.imApiCaller.UserToken(ctx, config.GetIMAdmin(mctx.GetOpUserID(ctx)), constant2.AdminPlatformID)
@@ -118,3 +134,108 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 
 	return &chat.ChangePasswordResp{}, nil
 }
+
+func (o *chatSvr) SetMustChangePassword(ctx context.Context, req *chat.SetMustChangePasswordReq) (*chat.SetMustChangePasswordResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetMustChangePassword(ctx, req.UserIDs, req.MustChange); err != nil {
+		return nil, err
+	}
+	return &chat.SetMustChangePasswordResp{}, nil
+}
+
+// passwordPolicy returns the days a password is valid and the number of latest passwords that can not be reused, 0 disables them.
+func (o *chatSvr) passwordPolicy(ctx context.Context) (int, int, error) {
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	expireDays, _ := strconv.Atoi(conf[constant.PasswordExpireDaysConfigKey])
+	history, _ := strconv.Atoi(conf[constant.PasswordHistoryCountConfigKey])
+	return expireDays, history, nil
+}
+
+// changePassword applies the password policy and stores the new password.
+func (o *chatSvr) changePassword(ctx context.Context, userID string, pwd string, mustChange bool, verifyCodeID *uint) error {
+	_, history, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if history > 0 {
+		account, err := o.Database.GetAccount(ctx, userID)
+		if err != nil {
+			return err
+		}
+		histories, err := o.Database.FindPasswordHistory(ctx, userID, history)
+		if err != nil {
+			return err
+		}
+		used := append([]string{account.Password}, utils.Slice(histories, func(h *chat2.PasswordHistory) string { return h.Password })...)
+		for _, stored := range used {
+			if match, _ := password.Verify(stored, pwd); match {
+				return eerrs.ErrPasswordReused.Wrap(fmt.Sprintf("the latest %d passwords can not be reused", history))
+			}
+		}
+	}
+	hashed, err := password.Hash(pwd)
+	if err != nil {
+		return err
+	}
+	return o.Database.ChangePassword(ctx, userID, hashed, mustChange, history, verifyCodeID)
+}
+
+// needChangePassword reports whether the login only gets a token to change the password.
+// Accounts without a password and users of the directory are never asked.
+func (o *chatSvr) needChangePassword(ctx context.Context, userID string) (bool, error) {
+	account, err := o.Database.GetAccount(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if account.Password == "" {
+		return false, nil
+	}
+	if identity, err := o.directoryIdentity(ctx, userID); err != nil {
+		return false, err
+	} else if identity != nil {
+		return false, nil
+	}
+	if account.MustChange {
+		return true, nil
+	}
+	expireDays, _, err := o.passwordPolicy(ctx)
+	if err != nil {
+		return false, err
+	}
+	return expireDays > 0 && time.Since(account.ChangeTime) > time.Duration(expireDays)*24*time.Hour, nil
+}
+
+// hashPassword leaves an empty password empty, such accounts can only log in by verify code.
+func (o *chatSvr) hashPassword(pwd string) (string, error) {
+	if pwd == "" {
+		return "", nil
+	}
+	return password.Hash(pwd)
+}
+
+// checkPassword verifies the password and replaces a legacy md5 row with its hash.
+func (o *chatSvr) checkPassword(ctx context.Context, account *chat2.Account, pwd string) error {
+	match, upgrade := password.Verify(account.Password, pwd)
+	if !match {
+		return eerrs.ErrPassword.Wrap()
+	}
+	if upgrade {
+		hashed, err := password.Hash(pwd)
+		if err != nil {
+			return err
+		}
+		if err := o.Database.UpdatePassword(ctx, account.UserID, hashed); err != nil {
+			log.ZError(ctx, "upgrade password hash failed", err, "userID", account.UserID)
+		}
+	}
+	return nil
+}
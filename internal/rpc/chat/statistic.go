@@ -18,12 +18,16 @@ import (
 	"context"
 	"time"
 
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/tools/errs"
 )
 
 func (o *chatSvr) UserLoginCount(ctx context.Context, req *chat.UserLoginCountReq) (*chat.UserLoginCountResp, error) {
 	resp := &chat.UserLoginCountResp{}
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionStatistic); err != nil {
+		return nil, err
+	}
 	if req.Start > req.End {
 		return nil, errs.ErrArgs.Wrap("start > end")
 	}
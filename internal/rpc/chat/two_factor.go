@@ -0,0 +1,411 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/loginlock"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/totp"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	twoFactorChallengeExpire     = time.Minute * 5
+	twoFactorChallengeMaxAttempt = 5
+	twoFactorRecoveryCodeCount   = 10
+)
+
+func (o *chatSvr) needTwoFactor(ctx context.Context) (bool, error) {
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	return utils.Contain(strings.ToLower(conf[constant.NeedTwoFactorLoginConfigKey]), "1", "true", "yes"), nil
+}
+
+// takeTwoFactor returns nil when the user has never set up two-factor authentication.
+func (o *chatSvr) takeTwoFactor(ctx context.Context, userID string) (*chat2.UserTwoFactor, error) {
+	tf, err := o.Database.TakeTwoFactor(ctx, userID)
+	if err == nil {
+		return tf, nil
+	} else if o.Database.IsNotFound(err) {
+		return nil, nil
+	}
+	return nil, err
+}
+
+func (o *chatSvr) genChallenge() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// twoFactorChallenge returns a non-empty challenge when the login has to be completed with a second factor.
+func (o *chatSvr) twoFactorChallenge(ctx context.Context, userID string, platform int32, deviceID string, ip string) (string, bool, error) {
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return "", false, err
+	}
+	enabled := tf != nil && tf.Enabled
+	if !enabled {
+		required, err := o.needTwoFactor(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		if !required {
+			return "", false, nil
+		}
+	}
+	challenge, err := o.genChallenge()
+	if err != nil {
+		return "", false, err
+	}
+	value := &cache.LoginChallenge{
+		UserID:   userID,
+		UserType: constant.NormalUser,
+		Platform: platform,
+		DeviceID: deviceID,
+		IP:       ip,
+		Setup:    !enabled,
+	}
+	if err := o.Database.SetLoginChallenge(ctx, challenge, value, twoFactorChallengeExpire); err != nil {
+		return "", false, err
+	}
+	return challenge, !enabled, nil
+}
+
+func (o *chatSvr) takeLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error) {
+	if challenge == "" {
+		return nil, errs.ErrArgs.Wrap("challenge is empty")
+	}
+	value, err := o.Database.GetLoginChallenge(ctx, challenge)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap()
+		}
+		return nil, err
+	}
+	if value.UserType != constant.NormalUser {
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("challenge type error")
+	}
+	return value, nil
+}
+
+// checkTwoFactorCode validates a totp code or, when code is empty, consumes a recovery code.
+func (o *chatSvr) checkTwoFactorCode(ctx context.Context, tf *chat2.UserTwoFactor, code string, recoveryCode string) error {
+	if code != "" {
+		step, ok := totp.Validate(tf.Secret, code, time.Now(), tf.LastStep)
+		if !ok {
+			return eerrs.ErrTwoFactorCodeNotMatch.Wrap()
+		}
+		if !tf.Enabled {
+			return nil
+		}
+		ok, err := o.Database.UseTwoFactorStep(ctx, tf.UserID, step)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return eerrs.ErrTwoFactorCodeNotMatch.Wrap("code already used")
+		}
+		return nil
+	}
+	if recoveryCode == "" || !tf.Enabled {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	hash := totp.HashRecoveryCode(recoveryCode)
+	codes := utils.Filter(strings.Split(tf.RecoveryCodes, ","), func(s string) (string, bool) { return s, s != "" })
+	index := utils.IndexOf(hash, codes...)
+	if index < 0 {
+		return eerrs.ErrTwoFactorCodeNotMatch.Wrap("recovery code not match")
+	}
+	codes = append(codes[:index], codes[index+1:]...)
+	ok, err := o.Database.UseTwoFactorRecoveryCode(ctx, tf.UserID, tf.RecoveryCodes, codes)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return eerrs.ErrTwoFactorCodeNotMatch.Wrap("recovery code already used")
+	}
+	return nil
+}
+
+func (o *chatSvr) genRecoveryCodes() ([]string, []string, error) {
+	codes, err := totp.GenerateRecoveryCodes(twoFactorRecoveryCodeCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return codes, utils.Slice(codes, totp.HashRecoveryCode), nil
+}
+
+func (o *chatSvr) setupTwoFactor(ctx context.Context, userID string) (*chat.SetupTwoFactorResp, error) {
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf != nil && tf.Enabled {
+		return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.SetTwoFactorSecret(ctx, userID, secret); err != nil {
+		return nil, err
+	}
+	name := attribute.Account
+	if name == "" {
+		name = attribute.Email
+	}
+	if name == "" {
+		name = userID
+	}
+	return &chat.SetupTwoFactorResp{Secret: secret, Uri: totp.URI(config.Config.TwoFactor.Issuer, name, secret)}, nil
+}
+
+// enableTwoFactor confirms the pending secret with the first code and returns the recovery codes.
+func (o *chatSvr) enableTwoFactor(ctx context.Context, userID string, code string) ([]string, error) {
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil {
+		return nil, errs.ErrArgs.Wrap("two factor not set up")
+	}
+	if tf.Enabled {
+		return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+	}
+	step, ok := totp.Validate(tf.Secret, code, time.Now(), 0)
+	if !ok {
+		return nil, eerrs.ErrTwoFactorCodeNotMatch.Wrap()
+	}
+	codes, hashes, err := o.genRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.EnableTwoFactor(ctx, userID, step, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (o *chatSvr) GetTwoFactorStatus(ctx context.Context, req *chat.GetTwoFactorStatusReq) (*chat.GetTwoFactorStatusResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required, err := o.needTwoFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.GetTwoFactorStatusResp{Required: required}
+	if tf != nil && tf.Enabled {
+		resp.Enabled = true
+		for _, s := range strings.Split(tf.RecoveryCodes, ",") {
+			if s != "" {
+				resp.RecoveryCodeCount++
+			}
+		}
+	}
+	return resp, nil
+}
+
+func (o *chatSvr) SetupTwoFactor(ctx context.Context, req *chat.SetupTwoFactorReq) (*chat.SetupTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if req.Challenge != "" {
+		value, err := o.takeLoginChallenge(ctx, req.Challenge)
+		if err != nil {
+			return nil, err
+		}
+		if !value.Setup {
+			return nil, eerrs.ErrTwoFactorEnabled.Wrap()
+		}
+		return o.setupTwoFactor(ctx, value.UserID)
+	}
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.setupTwoFactor(ctx, userID)
+}
+
+func (o *chatSvr) EnableTwoFactor(ctx context.Context, req *chat.EnableTwoFactorReq) (*chat.EnableTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := o.enableTwoFactor(ctx, userID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &chat.EnableTwoFactorResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) DisableTwoFactor(ctx context.Context, req *chat.DisableTwoFactorReq) (*chat.DisableTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	required, err := o.needTwoFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		return nil, eerrs.ErrTwoFactorRequired.Wrap()
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return &chat.DisableTwoFactorResp{}, nil
+	}
+	if err := o.checkTwoFactorCode(ctx, tf, req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelTwoFactor(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &chat.DisableTwoFactorResp{}, nil
+}
+
+func (o *chatSvr) GenTwoFactorRecoveryCodes(ctx context.Context, req *chat.GenTwoFactorRecoveryCodesReq) (*chat.GenTwoFactorRecoveryCodesResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tf, err := o.takeTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return nil, errs.ErrArgs.Wrap("two factor not enabled")
+	}
+	if err := o.checkTwoFactorCode(ctx, tf, req.Code, ""); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := o.genRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTwoFactorRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return &chat.GenTwoFactorRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) TwoFactorLogin(ctx context.Context, req *chat.TwoFactorLoginReq) (*chat.TwoFactorLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	value, err := o.takeLoginChallenge(ctx, req.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{loginLockUser + value.UserID}
+	if value.IP != "" {
+		keys = append(keys, loginLockIP+value.IP)
+	}
+	if err := loginlock.Check(ctx, o.Database, keys...); err != nil {
+		return nil, err
+	}
+	attempt, err := o.Database.IncrLoginChallengeAttempt(ctx, req.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	if attempt > twoFactorChallengeMaxAttempt {
+		if err := o.Database.DelLoginChallenge(ctx, req.Challenge); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("too many attempts")
+	}
+	resp := &chat.TwoFactorLoginResp{UserID: value.UserID, Platform: value.Platform}
+	resp.RecoveryCodes, err = o.checkSecondFactor(ctx, value, req.Code, req.RecoveryCode)
+	if err != nil {
+		// the second factor counts towards the same lock as the password, a new challenge does not reset it.
+		attribute, attrErr := o.Database.GetAttribute(ctx, value.UserID)
+		if attrErr != nil {
+			return nil, attrErr
+		}
+		if lockErr := o.loginFailed(ctx, attribute, value.IP); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, err
+	}
+	if err := o.Database.DelLoginChallenge(ctx, req.Challenge); err != nil {
+		return nil, err
+	}
+	resp.ChatToken, resp.RefreshToken, resp.PasswordChange, err = o.loginSuccess(ctx, value.UserID, value.Platform, value.DeviceID, value.IP, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// checkSecondFactor verifies the code of the challenge, it returns the recovery codes when two-factor authentication got set up by it.
+func (o *chatSvr) checkSecondFactor(ctx context.Context, value *cache.LoginChallenge, code string, recoveryCode string) ([]string, error) {
+	if value.Code != "" {
+		return nil, checkLoginRiskCode(value, code)
+	}
+	if value.Setup {
+		return o.enableTwoFactor(ctx, value.UserID, code)
+	}
+	tf, err := o.takeTwoFactor(ctx, value.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if tf == nil || !tf.Enabled {
+		return nil, eerrs.ErrTwoFactorChallengeExpired.Wrap("two factor disabled")
+	}
+	return nil, o.checkTwoFactorCode(ctx, tf, code, recoveryCode)
+}
+
+func (o *chatSvr) ResetUserTwoFactor(ctx context.Context, req *chat.ResetUserTwoFactorReq) (*chat.ResetUserTwoFactorResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelTwoFactor(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return &chat.ResetUserTwoFactorResp{}, nil
+}
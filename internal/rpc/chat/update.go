@@ -63,6 +63,12 @@ func ToDBAttributeUpdate(req *chat.UpdateUserInfoReq) (map[string]any, error) {
 	if req.GlobalRecvMsgOpt != nil {
 		update["global_recv_msg_opt"] = req.GlobalRecvMsgOpt.Value
 	}
+	if req.Language != nil {
+		if len(req.Language.Value) > 16 {
+			return nil, errs.ErrArgs.Wrap("language is too long")
+		}
+		update["language"] = req.Language.Value
+	}
 
 	if req.EnglishName != nil {
 		update["english_name"] = req.EnglishName.Value
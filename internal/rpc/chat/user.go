@@ -54,6 +54,9 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 		if req.PhoneNumber != nil {
 			return nil, errs.ErrNoPermission.Wrap("phoneNumber can not be updated")
 		}
+		if req.Email != nil {
+			return nil, errs.ErrNoPermission.Wrap("email can not be updated")
+		}
 		if req.Account != nil {
 			return nil, errs.ErrNoPermission.Wrap("account can not be updated")
 		}
@@ -119,7 +122,7 @@ func (o *chatSvr) FindUserPublicInfo(ctx context.Context, req *chat.FindUserPubl
 }
 
 func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountReq) (*chat.AddUserAccountResp, error) {
-	if _, _, err := mctx.Check(ctx); err != nil {
+	if err := o.Admin.CheckPermission(ctx, constant.PermissionUser); err != nil {
 		return nil, err
 	}
 
@@ -154,9 +157,13 @@ func (o *chatSvr) AddUserAccount(ctx context.Context, req *chat.AddUserAccountRe
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	hashedPassword, err := o.hashPassword(req.User.Password)
+	if err != nil {
+		return nil, err
+	}
 	account := &chat2.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       hashedPassword,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
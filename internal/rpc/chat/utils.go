@@ -18,6 +18,7 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	pbchat "github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/chat/pkg/proto/common"
 )
 
@@ -58,6 +59,7 @@ func DbToPbUserFullInfo(attribute *chat.Attribute) *common.UserFullInfo {
 		AllowVibration:   attribute.AllowVibration,
 		GlobalRecvMsgOpt: attribute.GlobalRecvMsgOpt,
 		RegisterType:     attribute.RegisterType,
+		Language:         attribute.Language,
 
 		EnglishName: attribute.EnglishName,
 		Station:     attribute.Station,
@@ -86,3 +88,14 @@ func DbToPbLogInfo(log *chat.Log) *common.LogInfo {
 func DbToPbLogInfos(logs []*chat.Log) []*common.LogInfo {
 	return utils.Slice(logs, DbToPbLogInfo)
 }
+
+func DbToPbExternalIdentity(identity *chat.ExternalIdentity) *pbchat.ExternalIdentity {
+	return &pbchat.ExternalIdentity{
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		UserID:        identity.UserID,
+		Email:         identity.Email,
+		LastLoginTime: identity.LastLoginTime.UnixMilli(),
+		CreateTime:    identity.CreateTime.UnixMilli(),
+	}
+}
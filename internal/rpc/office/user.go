@@ -0,0 +1,58 @@
+package office
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/office"
+)
+
+const exportPageSize = 100
+
+// DeleteUserData deletes the tags, sent messages, moments, comments and likes of the users, used when accounts are purged.
+func (o *officeServer) DeleteUserData(ctx context.Context, req *office.DeleteUserDataReq) (*office.DeleteUserDataResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	for _, userID := range req.UserIDs {
+		if err := o.db.DeleteUserData(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	return &office.DeleteUserDataResp{}, nil
+}
+
+// ExportUserData returns the tags, sent messages and moments of the user for the data export.
+func (o *officeServer) ExportUserData(ctx context.Context, req *office.ExportUserDataReq) (*office.ExportUserDataResp, error) {
+	if err := mctx.CheckAdminOr(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	tags, err := o.GetUserTags(ctx, &office.GetUserTagsReq{UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+	resp := &office.ExportUserDataResp{Tags: tags.Tags}
+	for pageNumber := int32(1); ; pageNumber++ {
+		logs, err := o.GetTagSendLogs(ctx, &office.GetTagSendLogsReq{UserID: req.UserID, Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: exportPageSize}})
+		if err != nil {
+			return nil, err
+		}
+		resp.TagSendLogs = append(resp.TagSendLogs, logs.TagSendLogs...)
+		if len(logs.TagSendLogs) < exportPageSize {
+			break
+		}
+	}
+	for pageNumber := int32(1); ; pageNumber++ {
+		workMoments, err := o.GetUserSendWorkMoments(ctx, &office.GetUserSendWorkMomentsReq{UserID: req.UserID, Pagination: &sdkws.RequestPagination{PageNumber: pageNumber, ShowNumber: exportPageSize}})
+		if err != nil {
+			return nil, err
+		}
+		resp.WorkMoments = append(resp.WorkMoments, workMoments.WorkMoments...)
+		if len(workMoments.WorkMoments) < exportPageSize {
+			break
+		}
+	}
+	return resp, nil
+}
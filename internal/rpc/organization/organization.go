@@ -8,6 +8,7 @@ import (
 	organization2 "github.com/OpenIMSDK/chat/pkg/common/db/model/organization"
 	table "github.com/OpenIMSDK/chat/pkg/common/db/table/organization"
 	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
 	chat2 "github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/chat/pkg/proto/organization"
 	"github.com/OpenIMSDK/chat/pkg/rpclient/chat"
@@ -390,6 +391,20 @@ func (o *organizationSvr) DeleteUserInDepartment(ctx context.Context, req *organ
 	return &organization.DeleteUserInDepartmentResp{}, nil
 }
 
+// DeleteUserAllDepartment removes the users from every department, used when accounts are purged.
+func (o *organizationSvr) DeleteUserAllDepartment(ctx context.Context, req *organization.DeleteUserAllDepartmentReq) (*organization.DeleteUserAllDepartmentResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if len(req.UserIDs) == 0 {
+		return nil, errs.ErrArgs.Wrap("userIDs is empty")
+	}
+	if err := o.Database.DeleteDepartmentMemberByUserID(ctx, req.UserIDs); err != nil {
+		return nil, err
+	}
+	return &organization.DeleteUserAllDepartmentResp{}, nil
+}
+
 func (o *organizationSvr) UpdateUserInDepartment(ctx context.Context, req *organization.UpdateUserInDepartmentReq) (*organization.UpdateUserInDepartmentResp, error) {
 	resp := &organization.UpdateUserInDepartmentResp{}
 	update, err := UpdateDepartmentMember(req)
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/protocol/auth"
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/friend"
@@ -36,7 +37,9 @@ type CallerInterface interface {
 	UserToken(ctx context.Context, userID string, platform int32) (string, error)
 	InviteToGroup(ctx context.Context, userID string, groupIDs []string) error
 	UpdateUserInfo(ctx context.Context, userID string, nickName string, faceURL string) error
-	ForceOffLine(ctx context.Context, userID string) error
+	ForceOffLine(ctx context.Context, userID string, platformIDs ...int32) error
+	// UnregisterUser kicks the user and clears the profile in im, which has no api to delete a user.
+	UnregisterUser(ctx context.Context, userID string) error
 	RegisterUser(ctx context.Context, users []*sdkws.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
@@ -125,16 +128,29 @@ func (c *Caller) RegisterUser(ctx context.Context, users []*sdkws.UserInfo) erro
 	return err
 }
 
-func (c *Caller) ForceOffLine(ctx context.Context, userID string) error {
-	for id := range constant.PlatformID2Name {
+// ForceOffLine kicks the user on the given platforms, all platforms if none is given.
+func (c *Caller) ForceOffLine(ctx context.Context, userID string, platformIDs ...int32) error {
+	if len(platformIDs) == 0 {
+		for id := range constant.PlatformID2Name {
+			platformIDs = append(platformIDs, int32(id))
+		}
+	}
+	for _, id := range platformIDs {
 		_, _ = forceOffLine.Call(ctx, &auth.ForceLogoutReq{
-			PlatformID: int32(id),
+			PlatformID: id,
 			UserID:     userID,
 		})
 	}
 	return nil
 }
 
+func (c *Caller) UnregisterUser(ctx context.Context, userID string) error {
+	if err := c.ForceOffLine(ctx, userID); err != nil {
+		return err
+	}
+	return c.UpdateUserInfo(ctx, userID, constant2.DeletedUserNickname, "")
+}
+
 func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error) {
 	resp, err := getGroupsInfo.Call(ctx, &group.GetGroupsInfoReq{
 		GroupIDs: groupIDs,
@@ -25,6 +25,11 @@ type AdminLoginResp struct {
 	AdminUserID  string `json:"adminUserID"`
 	ImUserID     string `json:"imUserID"`
 	ImToken      string `json:"imToken"`
+
+	AdminRefreshToken  string   `json:"adminRefreshToken,omitempty"`
+	TwoFactorChallenge string   `json:"twoFactorChallenge,omitempty"`
+	TwoFactorSetup     bool     `json:"twoFactorSetup,omitempty"`
+	RecoveryCodes      []string `json:"recoveryCodes,omitempty"`
 }
 
 type SearchDefaultGroupResp struct {
@@ -36,3 +41,14 @@ type NewUserCountResp struct {
 	Total     int64            `json:"total"`
 	DateCount map[string]int64 `json:"date_count"`
 }
+
+type LDAPSyncResp struct {
+	DepartmentCreated int  `json:"departmentCreated"`
+	DepartmentUpdated int  `json:"departmentUpdated"`
+	DepartmentDeleted int  `json:"departmentDeleted"`
+	UserCreated       int  `json:"userCreated"`
+	UserUpdated       int  `json:"userUpdated"`
+	UserTerminated    int  `json:"userTerminated"`
+	Failed            int  `json:"failed"`
+	DeletionSkipped   bool `json:"deletionSkipped"` // the directory read looked incomplete, nothing was deleted or terminated
+}
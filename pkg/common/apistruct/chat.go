@@ -17,15 +17,26 @@ package apistruct
 import "github.com/OpenIMSDK/protocol/sdkws"
 
 type UserRegisterResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken            string `json:"imToken"`
+	ChatToken          string `json:"chatToken"`
+	RefreshToken       string `json:"refreshToken,omitempty"`
+	UserID             string `json:"userID"`
+	TwoFactorChallenge string `json:"twoFactorChallenge,omitempty"`
+	TwoFactorSetup     bool   `json:"twoFactorSetup,omitempty"`
+	RiskVerify         bool   `json:"riskVerify,omitempty"`
+	PasswordChange     bool   `json:"passwordChange,omitempty"`
 }
 
 type LoginResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken            string   `json:"imToken"`
+	ChatToken          string   `json:"chatToken"`
+	RefreshToken       string   `json:"refreshToken,omitempty"`
+	UserID             string   `json:"userID"`
+	TwoFactorChallenge string   `json:"twoFactorChallenge,omitempty"`
+	TwoFactorSetup     bool     `json:"twoFactorSetup,omitempty"`
+	RiskVerify         bool     `json:"riskVerify,omitempty"`
+	PasswordChange     bool     `json:"passwordChange,omitempty"`
+	RecoveryCodes      []string `json:"recoveryCodes,omitempty"`
 }
 
 type UpdateUserInfoResp struct{}
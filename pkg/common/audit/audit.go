@@ -0,0 +1,206 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit builds the hash-chained entries of the admin audit log.
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+const (
+	redacted     = "******"
+	maxTargetLen = 1024
+	maxDetailLen = 16 * 1024
+)
+
+// readPrefixes methods starting with them do not change anything and are not audited.
+var readPrefixes = []string{"Get", "Find", "Search", "Check", "Parse", "Verify"}
+
+// skipMethods are called with the admin identity by the services themselves, e.g. when a user logs in.
+var skipMethods = map[string]bool{
+	"CreateToken":       true,
+	"UseInvitationCode": true,
+}
+
+// ShouldAudit reports whether the rpc of the full method name writes and needs an audit entry.
+func ShouldAudit(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if name == "" || skipMethods[name] {
+		return false
+	}
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// Action is the short name of the rpc, /OpenIMChat.admin.admin/BlockUser is admin.BlockUser.
+func Action(fullMethod string) string {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return fullMethod
+	}
+	return service[strings.LastIndex(service, ".")+1:] + "." + method
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	switch key {
+	case "code", "verifycode", "recoverycode":
+		return true
+	}
+	for _, s := range []string{"password", "secret", "token", "apikey"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			if isSecret(k) {
+				t[k] = redacted
+			} else {
+				t[k] = redact(e)
+			}
+		}
+	case []any:
+		for i, e := range t {
+			t[i] = redact(e)
+		}
+	}
+	return v
+}
+
+// Detail returns the fields set in the request with secrets redacted, and the target they refer to.
+// Large requests like batch imports are truncated.
+func Detail(req any) (detail string, target string) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return "", ""
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return "", ""
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", ""
+	}
+	redact(fields)
+	data, err = json.Marshal(fields)
+	if err != nil {
+		return "", ""
+	}
+	return Truncate(string(data), maxDetailLen), Target(fields)
+}
+
+// Truncate cuts s to at most n bytes without splitting a character, the stored
+// value has to stay valid utf8 or the database would change it and break the hash.
+func Truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+func isTargetKey(key string) bool {
+	key = strings.ToLower(key)
+	switch key {
+	case "account", "ip", "ips":
+		return true
+	}
+	return strings.HasSuffix(key, "id") || strings.HasSuffix(key, "ids")
+}
+
+// Target joins the identifier fields of the request, like userID=["x"].
+func Target(fields map[string]any) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		if isTargetKey(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	arr := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v, err := json.Marshal(fields[k])
+		if err != nil {
+			continue
+		}
+		arr = append(arr, k+"="+string(v))
+	}
+	return Truncate(strings.Join(arr, " "), maxTargetLen)
+}
+
+// Hash covers every field of the entry and the hash of the previous entry.
+func Hash(prevHash string, e *admin.AuditLog) string {
+	h := sha256.New()
+	for _, s := range []string{
+		prevHash,
+		strconv.FormatInt(e.Seq, 10),
+		e.Operator,
+		e.Action,
+		e.Target,
+		e.Detail,
+		e.IP,
+		e.Error,
+		strconv.FormatInt(e.CreateTime.UnixMilli(), 10),
+	} {
+		fmt.Fprintf(h, "%d:%s;", len(s), s)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify checks that the entries continue the chain after prev, nil prev means they start the log.
+// It returns the seq of the first entry that was changed, removed or inserted.
+func Verify(prev *admin.AuditLog, logs []*admin.AuditLog) (int64, bool) {
+	for _, e := range logs {
+		var prevHash string
+		var seq int64 = 1
+		if prev != nil {
+			prevHash = prev.Hash
+			seq = prev.Seq + 1
+		}
+		if e.Seq != seq {
+			return seq, false
+		}
+		if e.PrevHash != prevHash || e.Hash != Hash(prevHash, e) {
+			return e.Seq, false
+		}
+		prev = e
+	}
+	return 0, true
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	pbadmin "github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+func chain(n int) []*admin.AuditLog {
+	var logs []*admin.AuditLog
+	var prevHash string
+	for i := 1; i <= n; i++ {
+		e := &admin.AuditLog{Seq: int64(i), Operator: "admin", Action: "admin.BlockUser", Target: `userID="u"`, CreateTime: time.UnixMilli(int64(i))}
+		e.PrevHash = prevHash
+		e.Hash = Hash(prevHash, e)
+		prevHash = e.Hash
+		logs = append(logs, e)
+	}
+	return logs
+}
+
+func TestVerify(t *testing.T) {
+	logs := chain(4)
+	if seq, ok := Verify(nil, logs); !ok {
+		t.Fatalf("valid chain broken at %d", seq)
+	}
+	if _, ok := Verify(logs[1], logs[2:]); !ok {
+		t.Fatal("valid chain after prev broken")
+	}
+	logs[2].Target = `userID="v"`
+	if seq, ok := Verify(nil, logs); ok || seq != 3 {
+		t.Fatalf("changed entry: seq=%d ok=%v", seq, ok)
+	}
+	logs = chain(4)
+	if seq, ok := Verify(nil, append(logs[:1], logs[2:]...)); ok || seq != 2 {
+		t.Fatalf("removed entry: seq=%d ok=%v", seq, ok)
+	}
+}
+
+func TestDetail(t *testing.T) {
+	detail, target := Detail(&pbadmin.ChangeAdminPasswordReq{UserID: "u1", CurrentPassword: "pw-old", NewPassword: "pw-new"})
+	if strings.Contains(detail, "pw-") {
+		t.Fatalf("password not redacted: %s", detail)
+	}
+	if target != `userID="u1"` {
+		t.Fatalf("unexpected target %s", target)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	for _, c := range []struct {
+		s      string
+		n      int
+		expect string
+	}{
+		{"abc", 5, "abc"},
+		{"abcdef", 3, "abc"},
+		{"a中文", 3, "a"},
+		{"a中文", 4, "a中"},
+		{"中", 2, ""},
+	} {
+		if s := Truncate(c.s, c.n); s != c.expect {
+			t.Errorf("Truncate(%q, %d) = %q, expect %q", c.s, c.n, s, c.expect)
+		}
+	}
+}
+
+func TestShouldAudit(t *testing.T) {
+	for method, expect := range map[string]bool{
+		"/OpenIMChat.admin.admin/BlockUser":       true,
+		"/OpenIMChat.admin.admin/SearchBlockUser": false,
+		"/OpenIMChat.admin.admin/CreateToken":     false,
+	} {
+		if ShouldAudit(method) != expect {
+			t.Errorf("%s: expect %v", method, expect)
+		}
+	}
+	if a := Action("/OpenIMChat.admin.admin/BlockUser"); a != "admin.BlockUser" {
+		t.Errorf("unexpected action %s", a)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package captcha issues the challenges answered before a verification code is sent.
+package captcha
+
+import (
+	"bytes"
+	"crypto/rand"
+	"image"
+	"image/png"
+	"math/big"
+	"sync"
+
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+// Built-in challenge types.
+const (
+	TypeImage  = "image"
+	TypeSlider = "slider"
+	TypePoW    = "pow"
+)
+
+// Challenge is what the client gets, the answer stays on the server.
+type Challenge struct {
+	Type       string
+	Image      []byte // png of the code or of the background with the hole
+	Piece      []byte // png of the piece to slide into the hole
+	PieceY     int    // top of the piece, the client only moves it horizontally
+	Prefix     string // hashcash prefix
+	Difficulty int    // leading zero bits of sha256(prefix + nonce)
+}
+
+// Generator issues the challenges of one type.
+type Generator interface {
+	// Generate returns the challenge and the answer to keep until it is verified.
+	Generate() (*Challenge, string, error)
+	// Verify checks the input of the client against the kept answer.
+	Verify(answer string, input string) bool
+}
+
+var (
+	lock       sync.RWMutex
+	generators = map[string]Generator{
+		TypeImage:  &Image{Length: 4},
+		TypeSlider: &Slider{Width: 280, Height: 160, Size: 48, Tolerance: 5},
+		TypePoW:    &PoW{Difficulty: 20},
+	}
+)
+
+// Register adds a generator or replaces the one of the type.
+func Register(typ string, g Generator) {
+	lock.Lock()
+	defer lock.Unlock()
+	generators[typ] = g
+}
+
+// Get returns the generator of the type, nil if there is none.
+func Get(typ string) Generator {
+	lock.RLock()
+	defer lock.RUnlock()
+	return generators[typ]
+}
+
+// randInt returns a uniform random number in [0, n).
+func randInt(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return buf.Bytes(), nil
+}
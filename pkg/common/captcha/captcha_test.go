@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"image/png"
+	"strconv"
+	"testing"
+)
+
+func TestImage(t *testing.T) {
+	g := Get(TypeImage)
+	c, answer, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := png.Decode(bytes.NewReader(c.Image)); err != nil {
+		t.Fatal(err)
+	}
+	if len(answer) != 4 {
+		t.Fatalf("got answer %s", answer)
+	}
+	if !g.Verify(answer, " "+answer+" ") {
+		t.Fatal("right answer rejected")
+	}
+	if g.Verify(answer, "") {
+		t.Fatal("empty answer accepted")
+	}
+}
+
+func TestSlider(t *testing.T) {
+	g := &Slider{Width: 200, Height: 100, Size: 40, Tolerance: 3}
+	c, answer, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := png.Decode(bytes.NewReader(c.Piece)); err != nil {
+		t.Fatal(err)
+	}
+	x, err := strconv.Atoi(answer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x < 40 || x > 120 || c.PieceY < 0 || c.PieceY > 60 {
+		t.Fatalf("piece out of range x=%d y=%d", x, c.PieceY)
+	}
+	if !g.Verify(answer, strconv.Itoa(x+3)) || !g.Verify(answer, strconv.Itoa(x-3)) {
+		t.Fatal("answer within tolerance rejected")
+	}
+	if g.Verify(answer, strconv.Itoa(x+4)) {
+		t.Fatal("answer out of tolerance accepted")
+	}
+}
+
+func TestPoW(t *testing.T) {
+	g := &PoW{Difficulty: 8}
+	c, answer, err := g.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nonce string
+	for i := 0; ; i++ {
+		nonce = strconv.Itoa(i)
+		if LeadingZeroBits(sha256.Sum256([]byte(c.Prefix+nonce))) >= c.Difficulty {
+			break
+		}
+	}
+	if !g.Verify(answer, nonce) {
+		t.Fatal("solved nonce rejected")
+	}
+	if g.Verify("30:"+c.Prefix, nonce) {
+		t.Fatal("nonce accepted for a higher difficulty")
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	imageScale   = 3
+	imageCharGap = 2
+	imageDigits  = "23456789"
+)
+
+// Image draws random digits, scaled and waved, over noise.
+type Image struct {
+	Length int
+}
+
+func (g *Image) Generate() (*Challenge, string, error) {
+	code := make([]byte, g.Length)
+	for i := range code {
+		code[i] = imageDigits[randInt(len(imageDigits))]
+	}
+	face := basicfont.Face7x13
+	charWidth := face.Advance + imageCharGap
+	small := image.NewGray(image.Rect(0, 0, charWidth*g.Length+imageCharGap, face.Height+4))
+	draw.Draw(small, small.Bounds(), image.White, image.Point{}, draw.Src)
+	for i, c := range code {
+		d := &font.Drawer{
+			Dst:  small,
+			Src:  image.Black,
+			Face: face,
+			Dot:  fixed.P(imageCharGap+i*charWidth, face.Ascent+1+randInt(3)),
+		}
+		d.DrawString(string(c))
+	}
+	bounds := small.Bounds()
+	img := image.NewRGBA(image.Rect(0, 0, bounds.Dx()*imageScale, bounds.Dy()*imageScale))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	ink := color.RGBA{R: uint8(randInt(128)), G: uint8(randInt(128)), B: uint8(randInt(128)), A: 255}
+	phase := float64(randInt(628)) / 100
+	for x := 0; x < img.Bounds().Dx(); x++ {
+		offset := int(math.Sin(float64(x)/12+phase) * 4)
+		for y := 0; y < img.Bounds().Dy(); y++ {
+			sy := (y + offset) / imageScale
+			if sy < 0 || sy >= bounds.Dy() {
+				continue
+			}
+			if small.GrayAt(x/imageScale, sy).Y < 128 {
+				img.Set(x, y, ink)
+			}
+		}
+	}
+	for i := 0; i < img.Bounds().Dx()*img.Bounds().Dy()/20; i++ {
+		img.Set(randInt(img.Bounds().Dx()), randInt(img.Bounds().Dy()), color.Gray{Y: uint8(randInt(200))})
+	}
+	for i := 0; i < 3; i++ {
+		drawLine(img, randInt(img.Bounds().Dx()/4), randInt(img.Bounds().Dy()), img.Bounds().Dx()-1-randInt(img.Bounds().Dx()/4), randInt(img.Bounds().Dy()), ink)
+	}
+	data, err := encodePNG(img)
+	if err != nil {
+		return nil, "", err
+	}
+	return &Challenge{Type: TypeImage, Image: data}, string(code), nil
+}
+
+func (g *Image) Verify(answer string, input string) bool {
+	return answer != "" && strings.TrimSpace(input) == answer
+}
+
+func drawLine(img draw.Image, x0, y0, x1, y1 int, c color.Color) {
+	steps := x1 - x0
+	if steps < 0 {
+		steps = -steps
+	}
+	if dy := y1 - y0; dy > steps {
+		steps = dy
+	} else if -dy > steps {
+		steps = -dy
+	}
+	if steps == 0 {
+		img.Set(x0, y0, c)
+		return
+	}
+	for i := 0; i <= steps; i++ {
+		img.Set(x0+(x1-x0)*i/steps, y0+(y1-y0)*i/steps, c)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// PoW is a hashcash style challenge, the client finds a nonce so that
+// sha256(prefix + nonce) starts with Difficulty zero bits.
+type PoW struct {
+	Difficulty int
+}
+
+func (g *PoW) Generate() (*Challenge, string, error) {
+	prefix := make([]byte, 16)
+	for i := range prefix {
+		prefix[i] = byte(randInt(256))
+	}
+	p := hex.EncodeToString(prefix)
+	return &Challenge{Type: TypePoW, Prefix: p, Difficulty: g.Difficulty}, strconv.Itoa(g.Difficulty) + ":" + p, nil
+}
+
+func (g *PoW) Verify(answer string, input string) bool {
+	d, prefix, ok := strings.Cut(answer, ":")
+	if !ok || input == "" {
+		return false
+	}
+	difficulty, err := strconv.Atoi(d)
+	if err != nil {
+		return false
+	}
+	return LeadingZeroBits(sha256.Sum256([]byte(prefix+input))) >= difficulty
+}
+
+// LeadingZeroBits counts the zero bits at the start of the hash.
+func LeadingZeroBits(hash [sha256.Size]byte) int {
+	var n int
+	for _, b := range hash {
+		if b != 0 {
+			return n + bits.LeadingZeros8(b)
+		}
+		n += 8
+	}
+	return n
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package captcha
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strconv"
+	"strings"
+)
+
+// Slider cuts a square piece out of a random background, the answer is the left edge of the hole.
+type Slider struct {
+	Width     int
+	Height    int
+	Size      int // side of the piece
+	Tolerance int // pixels the piece may be off
+}
+
+func (g *Slider) Generate() (*Challenge, string, error) {
+	bg := image.NewRGBA(image.Rect(0, 0, g.Width, g.Height))
+	from := randColor()
+	to := randColor()
+	for x := 0; x < g.Width; x++ {
+		c := color.RGBA{
+			R: uint8((int(from.R)*(g.Width-x) + int(to.R)*x) / g.Width),
+			G: uint8((int(from.G)*(g.Width-x) + int(to.G)*x) / g.Width),
+			B: uint8((int(from.B)*(g.Width-x) + int(to.B)*x) / g.Width),
+			A: 255,
+		}
+		for y := 0; y < g.Height; y++ {
+			bg.SetRGBA(x, y, c)
+		}
+	}
+	for i := 0; i < 12; i++ {
+		w := g.Size/2 + randInt(g.Size)
+		h := g.Size/2 + randInt(g.Size)
+		x := randInt(g.Width)
+		y := randInt(g.Height)
+		draw.Draw(bg, image.Rect(x, y, x+w, y+h), image.NewUniform(randColor()), image.Point{}, draw.Src)
+	}
+	// the hole is never at the very left, where the piece starts
+	x := g.Size + randInt(g.Width-g.Size*2)
+	y := randInt(g.Height - g.Size)
+	hole := image.Rect(x, y, x+g.Size, y+g.Size)
+	piece := image.NewRGBA(image.Rect(0, 0, g.Size, g.Size))
+	draw.Draw(piece, piece.Bounds(), bg, hole.Min, draw.Src)
+	draw.Draw(bg, hole, image.NewUniform(color.RGBA{A: 128}), image.Point{}, draw.Over)
+	bgData, err := encodePNG(bg)
+	if err != nil {
+		return nil, "", err
+	}
+	pieceData, err := encodePNG(piece)
+	if err != nil {
+		return nil, "", err
+	}
+	return &Challenge{Type: TypeSlider, Image: bgData, Piece: pieceData, PieceY: y}, strconv.Itoa(x), nil
+}
+
+func (g *Slider) Verify(answer string, input string) bool {
+	x, err := strconv.Atoi(answer)
+	if err != nil {
+		return false
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil {
+		return false
+	}
+	return v >= x-g.Tolerance && v <= x+g.Tolerance
+}
+
+func randColor() color.RGBA {
+	return color.RGBA{R: uint8(randInt(256)), G: uint8(randInt(256)), B: uint8(randInt(256)), A: 255}
+}
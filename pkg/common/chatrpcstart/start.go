@@ -20,6 +20,9 @@ import (
 	"strconv"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/database"
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
 	chatMw "github.com/OpenIMSDK/chat/pkg/common/mw"
 	"github.com/OpenIMSDK/chat/pkg/discovery_register"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
@@ -49,7 +52,14 @@ func Start(rpcPort int, rpcRegisterName string, prometheusPort int, rpcFn func(c
 	if err != nil {
 		return utils.Wrap1(err)
 	}
-	srv := grpc.NewServer(append(options, mw.GrpcServer())...)
+	db, err := dbconn.NewGormDB()
+	if err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&admin.AuditLog{}); err != nil {
+		return errs.Wrap(err)
+	}
+	srv := grpc.NewServer(append(options, mw.GrpcServer(), chatMw.GrpcAudit(database.NewAuditDatabase(db)))...)
 	defer srv.GracefulStop()
 	err = rpcFn(zkClient, srv)
 	if err != nil {
@@ -95,8 +95,89 @@ var Config struct {
 	ChatSecret  string  `yaml:"chatSecret"`
 	OpenIMUrl   string  `yaml:"openIMUrl"`
 	TokenPolicy struct {
-		Expire *int64 `yaml:"expire"`
+		Expire           *int64 `yaml:"expire"`
+		AccessExpire     int64  `yaml:"accessExpire"`
+		RefreshExpire    int64  `yaml:"refreshExpire"`
+		MultiLoginPolicy int    `yaml:"multiLoginPolicy"`
+		SigningKey       struct {
+			Algorithm         string `yaml:"algorithm"`
+			RotateInterval    int    `yaml:"rotateInterval"`
+			GracePeriod       int    `yaml:"gracePeriod"`
+			AcceptSecretUntil string `yaml:"acceptSecretUntil"`
+			EncryptionKey     string `yaml:"encryptionKey"`
+		} `yaml:"signingKey"`
 	} `yaml:"tokenPolicy"`
+	TwoFactor struct {
+		Issuer string `yaml:"issuer"`
+	} `yaml:"twoFactor"`
+	LoginLink struct {
+		URL    string `yaml:"url"`
+		Expire int    `yaml:"expire"`
+	} `yaml:"loginLink"`
+	OIDC struct {
+		StateExpire int            `yaml:"stateExpire"`
+		Providers   []OIDCProvider `yaml:"providers"`
+	} `yaml:"oidc"`
+	LDAP struct {
+		Enable             bool   `yaml:"enable"`
+		URL                string `yaml:"url"`
+		StartTLS           bool   `yaml:"startTLS"`
+		InsecureSkipVerify bool   `yaml:"insecureSkipVerify"`
+		BindDN             string `yaml:"bindDN"`
+		BindPassword       string `yaml:"bindPassword"`
+		BaseDN             string `yaml:"baseDN"`
+		UserFilter         string `yaml:"userFilter"`
+		DepartmentFilter   string `yaml:"departmentFilter"`
+		Attributes         struct {
+			ID          string `yaml:"id"`
+			Account     string `yaml:"account"`
+			Nickname    string `yaml:"nickname"`
+			Email       string `yaml:"email"`
+			Telephone   string `yaml:"telephone"`
+			Station     string `yaml:"station"`
+			EnglishName string `yaml:"englishName"`
+			Department  string `yaml:"department"`
+		} `yaml:"attributes"`
+		SyncInterval int `yaml:"syncInterval"`
+	} `yaml:"ldap"`
+	SCIM struct {
+		Token string `yaml:"token"`
+	} `yaml:"scim"`
+	AccountDeletion struct {
+		GracePeriod int `yaml:"gracePeriod"`
+	} `yaml:"accountDeletion"`
+	DataExport struct {
+		URL       string `yaml:"url"`
+		URLExpire int    `yaml:"urlExpire"`
+		Retention int    `yaml:"retention"`
+		Dir       string `yaml:"dir"`
+		Timeout   int    `yaml:"timeout"`
+	} `yaml:"dataExport"`
+	LoginLock struct {
+		MaxAttempts   int64 `yaml:"maxAttempts"`
+		IPMaxAttempts int64 `yaml:"ipMaxAttempts"`
+		Window        int   `yaml:"window"`
+		LockTime      int   `yaml:"lockTime"`
+		MaxLockTime   int   `yaml:"maxLockTime"`
+	} `yaml:"loginLock"`
+	LoginRecord struct {
+		Retention int `yaml:"retention"`
+	} `yaml:"loginRecord"`
+	LoginRisk struct {
+		Policy     string `yaml:"policy"`
+		GeoIP      string `yaml:"geoIP"`
+		IPv4Prefix int    `yaml:"ipv4Prefix"`
+		IPv6Prefix int    `yaml:"ipv6Prefix"`
+	} `yaml:"loginRisk"`
+	Captcha struct {
+		Type              string `yaml:"type"`
+		Expire            int    `yaml:"expire"`
+		Window            int    `yaml:"window"`
+		IPThreshold       int64  `yaml:"ipThreshold"`
+		AreaCodeThreshold int64  `yaml:"areaCodeThreshold"`
+		DeviceThreshold   int64  `yaml:"deviceThreshold"`
+		PoWDifficulty     int    `yaml:"powDifficulty"`
+	} `yaml:"captcha"`
 	VerifyCode struct {
 		ValidTime int    `yaml:"validTime"`
 		UintTime  int    `yaml:"uintTime"`
@@ -110,13 +191,19 @@ var Config struct {
 			AccessKeySecret              string `yaml:"accessKeySecret"`
 			SignName                     string `yaml:"signName"`
 			VerificationCodeTemplateCode string `yaml:"verificationCodeTemplateCode"`
+			AccountLockedTemplateCode    string `yaml:"accountLockedTemplateCode"`
+			LoginAlertTemplateCode       string `yaml:"loginAlertTemplateCode"`
+			PhoneChangedTemplateCode     string `yaml:"phoneChangedTemplateCode"`
 		} `yaml:"ali"`
+		Providers []SMSProvider `yaml:"providers"`
+		Routes    []SMSRoute    `yaml:"routes"`
 		Mail struct {
 			Title                   string `yaml:"title"`
 			SenderMail              string `yaml:"senderMail"`
 			SenderAuthorizationCode string `yaml:"senderAuthorizationCode"`
 			SmtpAddr                string `yaml:"smtpAddr"`
 			SmtpPort                int    `yaml:"smtpPort"`
+			DefaultLocale           string `yaml:"defaultLocale"`
 		} `yaml:"mail"`
 	} `yaml:"verifyCode"`
 	ProxyHeader string  `yaml:"proxyHeader"`
@@ -125,6 +212,39 @@ var Config struct {
 	UngroupedName string  `yaml:"ungroupedName"`
 }
 
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"clientID"`
+	ClientSecret string   `yaml:"clientSecret"`
+	RedirectURL  string   `yaml:"redirectURL"`
+	Scopes       []string `yaml:"scopes"`
+	AutoRegister bool     `yaml:"autoRegister"`
+	LinkByEmail  bool     `yaml:"linkByEmail"`
+}
+
+// SMSProvider is one sms service, the fields used depend on the type.
+type SMSProvider struct {
+	Name      string            `yaml:"name"`
+	Type      string            `yaml:"type"`
+	Endpoint  string            `yaml:"endpoint"`
+	Region    string            `yaml:"region"`
+	AppID     string            `yaml:"appID"`
+	Key       string            `yaml:"key"`
+	Secret    string            `yaml:"secret"`
+	SignName  string            `yaml:"signName"`
+	From      string            `yaml:"from"`
+	Headers   map[string]string `yaml:"headers"`
+	Body      string            `yaml:"body"`
+	Templates map[string]string `yaml:"templates"`
+}
+
+// SMSRoute sends the area codes through the providers, in failover order.
+type SMSRoute struct {
+	AreaCodes []string `yaml:"areaCodes"`
+	Providers []string `yaml:"providers"`
+}
+
 type Admin struct {
 	AdminID   string `yaml:"adminID"`
 	NickName  string `yaml:"nickname"`
@@ -25,13 +25,15 @@ const (
 
 const (
 	// verificationCode used for.
-	VerificationCodeForRegister      = 1 // Register
-	VerificationCodeForResetPassword = 2 // Reset password
-	VerificationCodeForLogin         = 3 // Login
-
-	VerificationCodeForRegisterSuffix = "_forRegister"
-	VerificationCodeForResetSuffix    = "_forReset"
-	VerificationCodeForLoginSuffix    = "_forLogin"
+	VerificationCodeForRegister       = 1 // Register
+	VerificationCodeForResetPassword  = 2 // Reset password
+	VerificationCodeForLogin          = 3 // Login
+	VerificationCodeForChangeIdentity = 4 // Change phone number or email
+
+	VerificationCodeForRegisterSuffix       = "_forRegister"
+	VerificationCodeForResetSuffix          = "_forReset"
+	VerificationCodeForLoginSuffix          = "_forLogin"
+	VerificationCodeForChangeIdentitySuffix = "_forChangeIdentity"
 )
 
 const LogFileName = "chat.log"
@@ -164,12 +166,48 @@ const (
 	RpcOperationID = constant.OperationID
 	RpcOpUserID    = constant.OpUserID
 	RpcOpUserType  = "opUserType"
+	RpcOpIP        = "opIP"
+	RpcOpLanguage  = "opLanguage" // Accept-Language of the request
 )
 
 const RpcCustomHeader = constant.RpcCustomHeader
 
 const NeedInvitationCodeRegisterConfigKey = "needInvitationCodeRegister"
 
+const NeedTwoFactorLoginConfigKey = "needTwoFactorLogin"
+
+// ChangeIdentityVerifyOldConfigKey whether changing the phone number or email also needs a code sent to the old address.
+const ChangeIdentityVerifyOldConfigKey = "changeIdentityVerifyOld"
+
+// NeedAdminTwoFactorConfigKey only super admins can change it.
+const NeedAdminTwoFactorConfigKey = "needAdminTwoFactor"
+
+// 免密登录方式开关
+const (
+	LoginBySmsCodeConfigKey   = "loginBySmsCode"
+	LoginByEmailCodeConfigKey = "loginByEmailCode"
+	LoginByMagicLinkConfigKey = "loginByMagicLink"
+)
+
+// 密码策略, 0表示不限制
+const (
+	PasswordExpireDaysConfigKey   = "passwordExpireDays"   // days before the password has to be changed
+	PasswordHistoryCountConfigKey = "passwordHistoryCount" // number of latest passwords that can not be reused
+)
+
+// TokenScopePassword limits a token to changing the password, it is issued while the password must be changed.
+const TokenScopePassword = "password"
+
+// DeletedUserNickname replaces the im nickname of a purged user.
+const DeletedUserNickname = "已注销用户"
+
+// status of a data export.
+const (
+	DataExportRunning = 1
+	DataExportSuccess = 2
+	DataExportFailed  = 3
+)
+
 const (
 	DefaultAllowVibration = 1
 	DefaultAllowBeep      = 1
@@ -231,3 +269,53 @@ const (
 	DefaultDepartmentOrder = 1000
 	UngroupedID            = "$ungrouped"
 )
+
+// api key scopes
+const (
+	ScopeUserImport = "user:import" // 批量导入用户
+	ScopeUserWrite  = "user:write"  // 添加用户
+	ScopeOrgRead    = "org:read"    // 查询部门及成员
+	ScopeOrgWrite   = "org:write"   // 修改部门及成员
+	ScopeStatsRead  = "stats:read"  // 查询统计
+)
+
+var ApiKeyScopes = []string{ScopeUserImport, ScopeUserWrite, ScopeOrgRead, ScopeOrgWrite, ScopeStatsRead}
+
+// ApiKeyScopePermissions are the permissions an api key gets from each of its scopes, only the ones the rpcs of the scoped routes check.
+var ApiKeyScopePermissions = map[string][]string{
+	ScopeUserImport: {PermissionUserCreate},
+	ScopeUserWrite:  {PermissionUserCreate},
+	ScopeOrgRead:    {PermissionOrganization},
+	ScopeOrgWrite:   {PermissionOrganization},
+	ScopeStatsRead:  {PermissionStatistic},
+}
+
+// admin role permissions, each one grants a route group of the admin api
+const (
+	PermissionDefault        = "default"         // 注册默认好友和群
+	PermissionInvitationCode = "invitation_code" // 邀请码
+	PermissionForbidden      = "forbidden"       // 禁止注册登录的ip
+	PermissionApplet         = "applet"          // 小程序
+	PermissionBlock          = "block"           // 封禁用户
+	PermissionUser           = "user"            // 用户账号
+	PermissionClientConfig   = "client_config"   // 客户端配置
+	PermissionStatistic      = "statistic"       // 统计
+	PermissionRtc            = "rtc"             // 音视频记录
+	PermissionLogs           = "logs"            // 日志
+	PermissionOrganization   = "organization"    // 组织架构
+	PermissionAudit          = "audit"           // 审计日志
+)
+
+// PermissionUserCreate allows creating users, api keys get it without the rest of the user and organization route groups.
+const PermissionUserCreate = "user_create"
+
+// ImpliedPermissions are the narrow permissions a role gets with each route group permission.
+var ImpliedPermissions = map[string][]string{
+	PermissionUser:         {PermissionUserCreate},
+	PermissionOrganization: {PermissionUserCreate},
+}
+
+var Permissions = []string{
+	PermissionDefault, PermissionInvitationCode, PermissionForbidden, PermissionApplet, PermissionBlock, PermissionUser,
+	PermissionClientConfig, PermissionStatistic, PermissionRtc, PermissionLogs, PermissionOrganization, PermissionAudit,
+}
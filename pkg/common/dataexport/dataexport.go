@@ -0,0 +1,186 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dataexport writes the zip of the personal data of a user to disk and signs the links downloading it.
+package dataexport
+
+import (
+	"archive/zip"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+// purpose keeps the tokens apart from the other links signed with the same secret.
+const purpose = "data_export"
+
+// File is one json file of the zip.
+type File struct {
+	Name string
+	Data any
+}
+
+// Zip writes every file as indented json, in the given order.
+func Zip(w io.Writer, files []File, modified time.Time) error {
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		data, err := json.MarshalIndent(file.Data, "", "  ")
+		if err != nil {
+			return errs.Wrap(err, file.Name)
+		}
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: file.Name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if _, err := fw.Write(data); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return errs.Wrap(zw.Close())
+}
+
+// Dir returns the directory the zips are kept in, dir is the configured one.
+func Dir(dir string) string {
+	if dir == "" {
+		return filepath.Join(os.TempDir(), "openim_chat_data_export")
+	}
+	return dir
+}
+
+// Path returns the zip of the export in dir.
+func Path(dir string, exportID string) (string, error) {
+	if exportID == "" || strings.ContainsAny(exportID, `/\.`) {
+		return "", errs.ErrArgs.Wrap("invalid export id")
+	}
+	return filepath.Join(dir, exportID+".zip"), nil
+}
+
+// Write writes the zip of the export into dir and returns its size, the zip only shows up once it is complete.
+func Write(dir string, exportID string, files []File, modified time.Time) (int64, error) {
+	path, err := Path(dir, exportID)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	f, err := os.CreateTemp(dir, exportID+".*.tmp")
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	defer os.Remove(f.Name())
+	if err := Zip(f, files, modified); err != nil {
+		f.Close()
+		return 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return 0, errs.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return info.Size(), nil
+}
+
+// Remove deletes the zips of the exports, missing ones are ignored.
+func Remove(dir string, exportIDs ...string) error {
+	for _, exportID := range exportIDs {
+		path, err := Path(dir, exportID)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// RemoveExpired deletes the files in dir last modified before, including the leftovers of interrupted writes.
+func RemoveExpired(dir string, before time.Time) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errs.Wrap(err)
+	}
+	var count int
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(before) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return count, errs.Wrap(err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+func sign(secret string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Sign returns the token of a link downloading the export until expire.
+func Sign(secret string, exportID string, expire time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{purpose, exportID, strconv.FormatInt(expire.Unix(), 10)}, "\n")))
+	return payload + "." + sign(secret, payload)
+}
+
+// Parse checks the signature and the expiry of the token and returns its exportID.
+func Parse(secret string, token string, now time.Time) (string, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(secret, payload))) {
+		return "", eerrs.ErrDataExportLinkInvalid.Wrap("signature error")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", eerrs.ErrDataExportLinkInvalid.Wrap("payload error")
+	}
+	fields := strings.Split(string(data), "\n")
+	if len(fields) != 3 || fields[0] != purpose {
+		return "", eerrs.ErrDataExportLinkInvalid.Wrap("payload error")
+	}
+	expire, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return "", eerrs.ErrDataExportLinkInvalid.Wrap("payload error")
+	}
+	if now.Unix() > expire {
+		return "", eerrs.ErrDataExportLinkInvalid.Wrap("link expired")
+	}
+	return fields[1], nil
+}
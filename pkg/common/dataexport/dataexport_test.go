@@ -0,0 +1,126 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataexport
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSignParse(t *testing.T) {
+	now := time.Now()
+	token := Sign("secret", "export1", now.Add(time.Minute))
+	exportID, err := Parse("secret", token, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exportID != "export1" {
+		t.Fatalf("got %s", exportID)
+	}
+	if _, err := Parse("other", token, now); err == nil {
+		t.Fatal("token signed with another secret accepted")
+	}
+	if _, err := Parse("secret", token, now.Add(time.Minute*2)); err == nil {
+		t.Fatal("expired token accepted")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+	size, err := Write(dir, "export1", []File{
+		{Name: "account.json", Data: map[string]string{"userID": "user1"}},
+		{Name: "tags.json", Data: []string{"a", "b"}},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	path, err := Path(dir, "export1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if info, err := os.Stat(path); err != nil || info.Size() != size {
+		t.Fatalf("size %d, stat %v %v", size, info, err)
+	}
+	if len(r.File) != 2 || r.File[0].Name != "account.json" || r.File[1].Name != "tags.json" {
+		t.Fatalf("unexpected files %v", r.File)
+	}
+	f, err := r.File[1].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tags []string
+	if err := json.Unmarshal(content, &tags); err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags[0] != "a" {
+		t.Fatalf("got %v", tags)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("temporary file left, got %d files", len(entries))
+	}
+}
+
+func TestPath(t *testing.T) {
+	for _, exportID := range []string{"", "../export1", "a/b", `a\b`} {
+		if _, err := Path("dir", exportID); err == nil {
+			t.Fatalf("export id %q accepted", exportID)
+		}
+	}
+}
+
+func TestRemoveExpired(t *testing.T) {
+	dir := t.TempDir()
+	for _, exportID := range []string{"old", "new"} {
+		if _, err := Write(dir, exportID, []File{{Name: "a.json", Data: 1}}, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old, _ := Path(dir, "old")
+	if err := os.Chtimes(old, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	count, err := RemoveExpired(dir, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("removed %d files", count)
+	}
+	if err := Remove(dir, "new", "missing"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RemoveExpired(filepath.Join(dir, "missing"), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	captcha        = "CHAT_CAPTCHA:"
+	verifyCodeSend = "CHAT_VERIFY_CODE_SEND:"
+)
+
+type CaptchaInterface interface {
+	SetCaptcha(ctx context.Context, captchaID string, typ string, answer string, expire time.Duration) error
+	// TakeCaptcha returns the type and the answer of the captcha and removes it, so every captcha can be answered only once.
+	TakeCaptcha(ctx context.Context, captchaID string) (string, string, error)
+	// IncrVerifyCodeSend returns the number of verify codes requested for the key within the window.
+	IncrVerifyCodeSend(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+type CaptchaRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewCaptchaInterface(rdb redis.UniversalClient) *CaptchaRedis {
+	return &CaptchaRedis{rdb: rdb}
+}
+
+func (c *CaptchaRedis) SetCaptcha(ctx context.Context, captchaID string, typ string, answer string, expire time.Duration) error {
+	key := captcha + captchaID
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"type":   typ,
+		"answer": answer,
+	})
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *CaptchaRedis) TakeCaptcha(ctx context.Context, captchaID string) (string, string, error) {
+	key := captcha + captchaID
+	pipe := c.rdb.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", "", errs.Wrap(err)
+	}
+	m := get.Val()
+	if len(m) == 0 {
+		return "", "", errs.Wrap(redis.Nil)
+	}
+	return m["type"], m["answer"], nil
+}
+
+func (c *CaptchaRedis) IncrVerifyCodeSend(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = verifyCodeSend + key
+	n, err := c.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if n == 1 {
+		if err := c.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return 0, errs.Wrap(err)
+		}
+	}
+	return n, nil
+}
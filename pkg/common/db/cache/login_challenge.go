@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginChallenge = "CHAT_LOGIN_CHALLENGE:"
+)
+
+// LoginChallenge is the pending state of a login waiting for a second factor.
+type LoginChallenge struct {
+	UserID   string
+	UserType int32
+	Platform int32
+	DeviceID string
+	IP       string
+	Setup    bool   // the user has to enrol before the login can be completed
+	Code     string // hash of the code sent for an unusual login, checked instead of the second factor
+}
+
+type LoginChallengeInterface interface {
+	SetLoginChallenge(ctx context.Context, challenge string, value *LoginChallenge, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, challenge string) (*LoginChallenge, error)
+	// IncrLoginChallengeAttempt returns the number of verification attempts made so far.
+	IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error)
+	DelLoginChallenge(ctx context.Context, challenge string) error
+}
+
+type LoginChallengeRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb}
+}
+
+func (l *LoginChallengeRedis) SetLoginChallenge(ctx context.Context, challenge string, value *LoginChallenge, expire time.Duration) error {
+	key := loginChallenge + challenge
+	pipe := l.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"user_id":   value.UserID,
+		"user_type": value.UserType,
+		"platform":  value.Platform,
+		"device_id": value.DeviceID,
+		"ip":        value.IP,
+		"setup":     value.Setup,
+		"attempt":   0,
+	})
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (l *LoginChallengeRedis) GetLoginChallenge(ctx context.Context, challenge string) (*LoginChallenge, error) {
+	m, err := l.rdb.HGetAll(ctx, loginChallenge+challenge).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(m) == 0 {
+		return nil, errs.Wrap(redis.Nil)
+	}
+	return &LoginChallenge{
+		UserID:   m["user_id"],
+		UserType: utils.StringToInt32(m["user_type"]),
+		Platform: utils.StringToInt32(m["platform"]),
+		DeviceID: m["device_id"],
+		IP:       m["ip"],
+		Setup:    m["setup"] == "1",
+	}, nil
+}
+
+func (l *LoginChallengeRedis) IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error) {
+	n, err := l.rdb.HIncrBy(ctx, loginChallenge+challenge, "attempt", 1).Result()
+	return n, errs.Wrap(err)
+}
+
+func (l *LoginChallengeRedis) DelLoginChallenge(ctx context.Context, challenge string) error {
+	return errs.Wrap(l.rdb.Del(ctx, loginChallenge+challenge).Err())
+}
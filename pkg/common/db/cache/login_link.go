@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginLink     = "CHAT_LOGIN_LINK:"
+	loginLinkSend = "CHAT_LOGIN_LINK_SEND:"
+)
+
+type LoginLinkInterface interface {
+	SetLoginLink(ctx context.Context, nonce string, userID string, expire time.Duration) error
+	// TakeLoginLink returns the userID of the link and removes it, so every link can be used only once.
+	TakeLoginLink(ctx context.Context, nonce string) (string, error)
+	// IncrLoginLinkSend returns the number of links sent to the email within the window.
+	IncrLoginLinkSend(ctx context.Context, email string, window time.Duration) (int64, error)
+}
+
+type LoginLinkRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginLinkInterface(rdb redis.UniversalClient) *LoginLinkRedis {
+	return &LoginLinkRedis{rdb: rdb}
+}
+
+func (l *LoginLinkRedis) SetLoginLink(ctx context.Context, nonce string, userID string, expire time.Duration) error {
+	return errs.Wrap(l.rdb.Set(ctx, loginLink+nonce, userID, expire).Err())
+}
+
+func (l *LoginLinkRedis) TakeLoginLink(ctx context.Context, nonce string) (string, error) {
+	key := loginLink + nonce
+	pipe := l.rdb.TxPipeline()
+	get := pipe.Get(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return get.Val(), nil
+}
+
+func (l *LoginLinkRedis) IncrLoginLinkSend(ctx context.Context, email string, window time.Duration) (int64, error) {
+	key := loginLinkSend + email
+	n, err := l.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if n == 1 {
+		if err := l.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return 0, errs.Wrap(err)
+		}
+	}
+	return n, nil
+}
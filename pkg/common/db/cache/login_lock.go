@@ -0,0 +1,131 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFail      = "CHAT_LOGIN_FAIL:"
+	loginLock      = "CHAT_LOGIN_LOCK:"
+	loginLockLevel = "CHAT_LOGIN_LOCK_LEVEL:"
+
+	// loginLockLevelExpire how long a key keeps its backoff level after the last lock.
+	loginLockLevelExpire = time.Hour * 24
+)
+
+// addLoginFailScript counts a failure and starts the window with the first one.
+var addLoginFailScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 or redis.call("PTTL", KEYS[1]) < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// LoginLockPolicy decides when a key is locked and for how long.
+type LoginLockPolicy struct {
+	MaxAttempts int64         // failures within Window that lock the key, 0 never locks
+	Window      time.Duration // failures older than this are forgotten
+	LockTime    time.Duration // lock time of the first lock, doubled on every further lock
+	MaxLockTime time.Duration // 0 means no cap
+}
+
+type LoginLockInterface interface {
+	// GetLoginLock returns how long the key stays locked, 0 if it is not locked.
+	GetLoginLock(ctx context.Context, key string) (time.Duration, error)
+	// AddLoginFail counts a failure and returns the lock time if the key got locked by it.
+	AddLoginFail(ctx context.Context, key string, policy *LoginLockPolicy) (time.Duration, error)
+	DelLoginFail(ctx context.Context, key string) error
+	// UnlockLogin removes the lock, the failures and the backoff level of the key.
+	UnlockLogin(ctx context.Context, keys []string) error
+}
+
+type LoginLockRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginLockInterface(rdb redis.UniversalClient) *LoginLockRedis {
+	return &LoginLockRedis{rdb: rdb}
+}
+
+func (l *LoginLockRedis) GetLoginLock(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := l.rdb.PTTL(ctx, loginLock+key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *LoginLockRedis) AddLoginFail(ctx context.Context, key string, policy *LoginLockPolicy) (time.Duration, error) {
+	if policy.MaxAttempts <= 0 {
+		return 0, nil
+	}
+	count, err := addLoginFailScript.Run(ctx, l.rdb, []string{loginFail + key}, policy.Window.Milliseconds()).Int64()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if count < policy.MaxAttempts {
+		return 0, nil
+	}
+	pipe := l.rdb.TxPipeline()
+	level := pipe.Incr(ctx, loginLockLevel+key)
+	pipe.Expire(ctx, loginLockLevel+key, loginLockLevelExpire)
+	pipe.Del(ctx, loginFail+key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	lockTime := policy.LockTime
+	for i := int64(1); i < level.Val() && lockTime < math.MaxInt64/2; i++ {
+		if policy.MaxLockTime > 0 && lockTime >= policy.MaxLockTime {
+			break
+		}
+		lockTime *= 2
+	}
+	if policy.MaxLockTime > 0 && lockTime > policy.MaxLockTime {
+		lockTime = policy.MaxLockTime
+	}
+	if err := l.rdb.Set(ctx, loginLock+key, level.Val(), lockTime).Err(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return lockTime, nil
+}
+
+func (l *LoginLockRedis) DelLoginFail(ctx context.Context, key string) error {
+	return errs.Wrap(l.rdb.Del(ctx, loginFail+key).Err())
+}
+
+func (l *LoginLockRedis) UnlockLogin(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	pipe := l.rdb.Pipeline()
+	for _, key := range keys {
+		pipe.Del(ctx, loginFail+key)
+		pipe.Del(ctx, loginLock+key)
+		pipe.Del(ctx, loginLockLevel+key)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	oidcState = "CHAT_OIDC_STATE:"
+)
+
+// OIDCState is the pending authorization request of a single sign-on login.
+type OIDCState struct {
+	Provider string
+	Verifier string
+	Nonce    string
+}
+
+type OIDCStateInterface interface {
+	SetOIDCState(ctx context.Context, state string, value *OIDCState, expire time.Duration) error
+	// TakeOIDCState returns the authorization request and removes it, so every state can be used only once.
+	TakeOIDCState(ctx context.Context, state string) (*OIDCState, error)
+}
+
+type OIDCStateRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewOIDCStateInterface(rdb redis.UniversalClient) *OIDCStateRedis {
+	return &OIDCStateRedis{rdb: rdb}
+}
+
+func (o *OIDCStateRedis) SetOIDCState(ctx context.Context, state string, value *OIDCState, expire time.Duration) error {
+	key := oidcState + state
+	pipe := o.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"provider": value.Provider,
+		"verifier": value.Verifier,
+		"nonce":    value.Nonce,
+	})
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (o *OIDCStateRedis) TakeOIDCState(ctx context.Context, state string) (*OIDCState, error) {
+	key := oidcState + state
+	pipe := o.rdb.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	m := get.Val()
+	if len(m) == 0 {
+		return nil, errs.Wrap(redis.Nil)
+	}
+	return &OIDCState{
+		Provider: m["provider"],
+		Verifier: m["verifier"],
+		Nonce:    m["nonce"],
+	}, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	refreshToken       = "CHAT_REFRESH_TOKEN:"
+	refreshTokenFamily = "CHAT_REFRESH_TOKEN_FAMILY:"
+)
+
+// RefreshToken is a single-use token, every rotation of one login shares the same family.
+type RefreshToken struct {
+	UserID   string
+	UserType int32
+	Family   string
+}
+
+type RefreshTokenInterface interface {
+	// SetRefreshToken saves the refresh token and records the access token issued with it in its family.
+	SetRefreshToken(ctx context.Context, token string, value *RefreshToken, accessToken string, expire time.Duration) error
+	GetRefreshToken(ctx context.Context, token string) (*RefreshToken, error)
+	// UseRefreshToken marks the token as used and returns false if it had already been used.
+	UseRefreshToken(ctx context.Context, token string) (bool, error)
+	ExistRefreshTokenFamily(ctx context.Context, family string) (bool, error)
+	// DelRefreshTokenFamily revokes the family and returns the access tokens issued in it.
+	DelRefreshTokenFamily(ctx context.Context, family string) ([]string, error)
+}
+
+type RefreshTokenRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRefreshTokenInterface(rdb redis.UniversalClient) *RefreshTokenRedis {
+	return &RefreshTokenRedis{rdb: rdb}
+}
+
+// getRefreshTokenKey only the digest of the token is kept in redis.
+func (r *RefreshTokenRedis) getRefreshTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return refreshToken + hex.EncodeToString(sum[:])
+}
+
+func (r *RefreshTokenRedis) SetRefreshToken(ctx context.Context, token string, value *RefreshToken, accessToken string, expire time.Duration) error {
+	key := r.getRefreshTokenKey(token)
+	familyKey := refreshTokenFamily + value.Family
+	pipe := r.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"user_id":   value.UserID,
+		"user_type": value.UserType,
+		"family":    value.Family,
+		"used":      0,
+	})
+	pipe.Expire(ctx, key, expire)
+	pipe.SAdd(ctx, familyKey, accessToken)
+	pipe.Expire(ctx, familyKey, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (r *RefreshTokenRedis) GetRefreshToken(ctx context.Context, token string) (*RefreshToken, error) {
+	m, err := r.rdb.HGetAll(ctx, r.getRefreshTokenKey(token)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(m) == 0 {
+		return nil, errs.Wrap(redis.Nil)
+	}
+	return &RefreshToken{
+		UserID:   m["user_id"],
+		UserType: utils.StringToInt32(m["user_type"]),
+		Family:   m["family"],
+	}, nil
+}
+
+func (r *RefreshTokenRedis) UseRefreshToken(ctx context.Context, token string) (bool, error) {
+	n, err := r.rdb.HIncrBy(ctx, r.getRefreshTokenKey(token), "used", 1).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n == 1, nil
+}
+
+func (r *RefreshTokenRedis) ExistRefreshTokenFamily(ctx context.Context, family string) (bool, error) {
+	n, err := r.rdb.Exists(ctx, refreshTokenFamily+family).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
+
+func (r *RefreshTokenRedis) DelRefreshTokenFamily(ctx context.Context, family string) ([]string, error) {
+	key := refreshTokenFamily + family
+	pipe := r.rdb.TxPipeline()
+	members := pipe.SMembers(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return members.Val(), nil
+}
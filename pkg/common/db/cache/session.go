@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	userSession = "CHAT_USER_SESSION:"
+)
+
+// Session is one login of a user, it is identified by the refresh token family.
+type Session struct {
+	Token        string `json:"token"` // the latest access token of the session
+	Platform     int32  `json:"platform"`
+	DeviceID     string `json:"deviceID"`
+	IP           string `json:"ip"`
+	LoginTime    int64  `json:"loginTime"`
+	LastSeenTime int64  `json:"lastSeenTime"`
+}
+
+type SessionInterface interface {
+	SetSession(ctx context.Context, userID string, sessionID string, value *Session, expire time.Duration) error
+	GetSessions(ctx context.Context, userID string) (map[string]*Session, error)
+	DelSessions(ctx context.Context, userID string, sessionIDs []string) error
+}
+
+type SessionRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewSessionInterface(rdb redis.UniversalClient) *SessionRedis {
+	return &SessionRedis{rdb: rdb}
+}
+
+func (s *SessionRedis) SetSession(ctx context.Context, userID string, sessionID string, value *Session, expire time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	key := userSession + userID
+	pipe := s.rdb.TxPipeline()
+	pipe.HSet(ctx, key, sessionID, string(data))
+	pipe.Expire(ctx, key, expire)
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (s *SessionRedis) GetSessions(ctx context.Context, userID string) (map[string]*Session, error) {
+	m, err := s.rdb.HGetAll(ctx, userSession+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessions := make(map[string]*Session, len(m))
+	for sessionID, data := range m {
+		var session Session
+		if err := json.Unmarshal([]byte(data), &session); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		sessions[sessionID] = &session
+	}
+	return sessions, nil
+}
+
+func (s *SessionRedis) DelSessions(ctx context.Context, userID string, sessionIDs []string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(s.rdb.HDel(ctx, userSession+userID, sessionIDs...).Err())
+}
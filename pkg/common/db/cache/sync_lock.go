@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const syncLock = "CHAT_SYNC_LOCK:"
+
+// unlockScript deletes the lock only if it still holds the token of the caller.
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// SyncLockInterface keeps a job from running on several replicas at the same time.
+type SyncLockInterface interface {
+	// TryLock takes the lock and returns the token to release it, "" if another replica holds it.
+	// The lock is released by itself after expire.
+	TryLock(ctx context.Context, name string, expire time.Duration) (string, error)
+	Unlock(ctx context.Context, name string, token string) error
+}
+
+type SyncLockRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewSyncLockInterface(rdb redis.UniversalClient) *SyncLockRedis {
+	return &SyncLockRedis{rdb: rdb}
+}
+
+func (s *SyncLockRedis) TryLock(ctx context.Context, name string, expire time.Duration) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	token := hex.EncodeToString(b)
+	ok, err := s.rdb.SetNX(ctx, syncLock+name, token, expire).Result()
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if !ok {
+		return "", nil
+	}
+	return token, nil
+}
+
+func (s *SyncLockRedis) Unlock(ctx context.Context, name string, token string) error {
+	return errs.Wrap(unlockScript.Run(ctx, s.rdb, []string{syncLock + name}, token).Err())
+}
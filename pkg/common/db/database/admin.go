@@ -16,6 +16,8 @@ package database
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/protocol/constant"
@@ -55,6 +57,7 @@ type AdminDatabaseInterface interface {
 	SearchIPForbidden(ctx context.Context, keyword string, state int32, page int32, size int32) (uint32, []*table.IPForbidden, error)
 	AddIPForbidden(ctx context.Context, ms []*table.IPForbidden) error
 	FindIPForbidden(ctx context.Context, ms []string) ([]*table.IPForbidden, error)
+	FindAllIPForbidden(ctx context.Context) ([]*table.IPForbidden, error)
 	DelIPForbidden(ctx context.Context, ips []string) error
 	FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error)
 	AddDefaultFriend(ctx context.Context, ms []*table.RegisterAddFriend) error
@@ -75,8 +78,53 @@ type AdminDatabaseInterface interface {
 	DelUserLimitLogin(ctx context.Context, ms []*table.LimitUserLoginIP) error
 	CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error)
 	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*table.LimitUserLoginIP, error)
+	FindLimitUserLoginIP(ctx context.Context, userID string) ([]*table.LimitUserLoginIP, error)
 	CacheToken(ctx context.Context, userID string, token string) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
+	KickTokens(ctx context.Context, userID string, tokens []string) error
+	SetRefreshToken(ctx context.Context, token string, value *cache.RefreshToken, accessToken string, expire time.Duration) error
+	GetRefreshToken(ctx context.Context, token string) (*cache.RefreshToken, error)
+	UseRefreshToken(ctx context.Context, token string) (bool, error)
+	ExistRefreshTokenFamily(ctx context.Context, family string) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, userID string, family string) error
+	SetSession(ctx context.Context, userID string, sessionID string, value *cache.Session, expire time.Duration) error
+	GetSessions(ctx context.Context, userID string) (map[string]*cache.Session, error)
+	DelSessions(ctx context.Context, userID string, sessionIDs []string) error
+	// RevokeSessions kicks every token of the sessions and stops them from being refreshed.
+	RevokeSessions(ctx context.Context, userID string, sessionIDs []string) error
+	UpdateAdminTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseAdminRecoveryCode stores the remaining recovery codes, it reports false if the codes changed since old was read.
+	UseAdminRecoveryCode(ctx context.Context, userID string, old string, recoveryCodes []string) (bool, error)
+	SetLoginChallenge(ctx context.Context, challenge string, value *cache.LoginChallenge, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error)
+	IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error)
+	DelLoginChallenge(ctx context.Context, challenge string) error
+	CountAdminLoginIP(ctx context.Context) (int64, error)
+	TakeAdminLoginIP(ctx context.Context, ip string) (*table.AdminLoginIP, error)
+	SearchAdminLoginIP(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.AdminLoginIP, error)
+	AddAdminLoginIP(ctx context.Context, ms []*table.AdminLoginIP) error
+	DelAdminLoginIP(ctx context.Context, ips []string) error
+	GetLoginLock(ctx context.Context, key string) (time.Duration, error)
+	AddLoginFail(ctx context.Context, key string, policy *cache.LoginLockPolicy) (time.Duration, error)
+	DelLoginFail(ctx context.Context, key string) error
+	UnlockLogin(ctx context.Context, keys []string) error
+	FindSigningKey(ctx context.Context) ([]*table.SigningKey, error)
+	CreateSigningKey(ctx context.Context, ms []*table.SigningKey) error
+	DelSigningKey(ctx context.Context, kids []string) error
+	CreateApiKey(ctx context.Context, ms []*table.ApiKey) error
+	TakeApiKey(ctx context.Context, keyID string) (*table.ApiKey, error)
+	SearchApiKey(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.ApiKey, error)
+	UpdateApiKey(ctx context.Context, keyID string, update map[string]any) error
+	DelApiKey(ctx context.Context, keyIDs []string) error
+	CreateRole(ctx context.Context, ms []*table.Role) error
+	TakeRole(ctx context.Context, roleID string) (*table.Role, error)
+	FindRole(ctx context.Context, roleIDs []string) ([]*table.Role, error)
+	SearchRole(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.Role, error)
+	UpdateRole(ctx context.Context, roleID string, update map[string]any) error
+	DelRole(ctx context.Context, roleIDs []string) error
+	SetAdminRole(ctx context.Context, userID string, ms []*table.AdminRole) error
+	FindAdminRole(ctx context.Context, userIDs []string) ([]*table.AdminRole, error)
+	FindRoleAdmin(ctx context.Context, roleIDs []string) ([]*table.AdminRole, error)
 }
 
 func NewAdminDatabase(db *gorm.DB, rdb redis.UniversalClient) AdminDatabaseInterface {
@@ -92,6 +140,15 @@ func NewAdminDatabase(db *gorm.DB, rdb redis.UniversalClient) AdminDatabaseInter
 		applet:             admin.NewApplet(db),
 		clientConfig:       admin.NewClientConfig(db),
 		cache:              cache.NewTokenInterface(rdb),
+		adminLoginIP:       admin.NewAdminLoginIP(db),
+		loginChallenge:     cache.NewLoginChallengeInterface(rdb),
+		refreshToken:       cache.NewRefreshTokenInterface(rdb),
+		session:            cache.NewSessionInterface(rdb),
+		loginLock:          cache.NewLoginLockInterface(rdb),
+		signingKey:         admin.NewSigningKey(db),
+		apiKey:             admin.NewApiKey(db),
+		role:               admin.NewRole(db),
+		adminRole:          admin.NewAdminRole(db),
 	}
 }
 
@@ -107,6 +164,15 @@ type AdminDatabase struct {
 	applet             table.AppletInterface
 	clientConfig       table.ClientConfigInterface
 	cache              cache.TokenInterface
+	adminLoginIP       table.AdminLoginIPInterface
+	loginChallenge     cache.LoginChallengeInterface
+	refreshToken       cache.RefreshTokenInterface
+	session            cache.SessionInterface
+	loginLock          cache.LoginLockInterface
+	signingKey         table.SigningKeyInterface
+	apiKey             table.ApiKeyInterface
+	role               table.RoleInterface
+	adminRole          table.AdminRoleInterface
 }
 
 func (o *AdminDatabase) InitAdmin(ctx context.Context) error {
@@ -133,6 +199,9 @@ func (o *AdminDatabase) AddAdminAccount(ctx context.Context, admin *table.Admin)
 }
 
 func (o *AdminDatabase) DelAdminAccount(ctx context.Context, userIDs []string) error {
+	if err := o.adminRole.DeleteUserID(ctx, userIDs); err != nil {
+		return err
+	}
 	return o.admin.Delete(ctx, userIDs)
 }
 
@@ -212,6 +281,10 @@ func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*ta
 	return o.ipForbidden.Find(ctx, ms)
 }
 
+func (o *AdminDatabase) FindAllIPForbidden(ctx context.Context) ([]*table.IPForbidden, error) {
+	return o.ipForbidden.FindAll(ctx)
+}
+
 func (o *AdminDatabase) DelIPForbidden(ctx context.Context, ips []string) error {
 	return o.ipForbidden.Delete(ctx, ips)
 }
@@ -292,6 +365,10 @@ func (o *AdminDatabase) GetLimitUserLoginIP(ctx context.Context, userID string,
 	return o.limitUserLoginIP.Take(ctx, userID, ip)
 }
 
+func (o *AdminDatabase) FindLimitUserLoginIP(ctx context.Context, userID string) ([]*table.LimitUserLoginIP, error) {
+	return o.limitUserLoginIP.FindUserID(ctx, userID)
+}
+
 func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token string) error {
 	return o.cache.AddTokenFlag(ctx, userID, token, constant.NormalToken)
 }
@@ -299,3 +376,190 @@ func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token str
 func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[string]int32, error) {
 	return o.cache.GetTokensWithoutError(ctx, userID)
 }
+
+func (o *AdminDatabase) KickTokens(ctx context.Context, userID string, tokens []string) error {
+	for _, token := range tokens {
+		if err := o.cache.AddTokenFlag(ctx, userID, token, constant.KickedToken); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *AdminDatabase) SetRefreshToken(ctx context.Context, token string, value *cache.RefreshToken, accessToken string, expire time.Duration) error {
+	return o.refreshToken.SetRefreshToken(ctx, token, value, accessToken, expire)
+}
+
+func (o *AdminDatabase) GetRefreshToken(ctx context.Context, token string) (*cache.RefreshToken, error) {
+	return o.refreshToken.GetRefreshToken(ctx, token)
+}
+
+func (o *AdminDatabase) UseRefreshToken(ctx context.Context, token string) (bool, error) {
+	return o.refreshToken.UseRefreshToken(ctx, token)
+}
+
+func (o *AdminDatabase) ExistRefreshTokenFamily(ctx context.Context, family string) (bool, error) {
+	return o.refreshToken.ExistRefreshTokenFamily(ctx, family)
+}
+
+func (o *AdminDatabase) RevokeRefreshTokenFamily(ctx context.Context, userID string, family string) error {
+	tokens, err := o.refreshToken.DelRefreshTokenFamily(ctx, family)
+	if err != nil {
+		return err
+	}
+	return o.KickTokens(ctx, userID, tokens)
+}
+
+func (o *AdminDatabase) SetSession(ctx context.Context, userID string, sessionID string, value *cache.Session, expire time.Duration) error {
+	return o.session.SetSession(ctx, userID, sessionID, value, expire)
+}
+
+func (o *AdminDatabase) GetSessions(ctx context.Context, userID string) (map[string]*cache.Session, error) {
+	return o.session.GetSessions(ctx, userID)
+}
+
+func (o *AdminDatabase) DelSessions(ctx context.Context, userID string, sessionIDs []string) error {
+	return o.session.DelSessions(ctx, userID, sessionIDs)
+}
+
+func (o *AdminDatabase) RevokeSessions(ctx context.Context, userID string, sessionIDs []string) error {
+	for _, sessionID := range sessionIDs {
+		if err := o.RevokeRefreshTokenFamily(ctx, userID, sessionID); err != nil {
+			return err
+		}
+	}
+	return o.session.DelSessions(ctx, userID, sessionIDs)
+}
+
+func (o *AdminDatabase) UpdateAdminTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.admin.UpdateTwoFactorStep(ctx, userID, step)
+}
+
+func (o *AdminDatabase) UseAdminRecoveryCode(ctx context.Context, userID string, old string, recoveryCodes []string) (bool, error) {
+	return o.admin.CompareAndSetRecoveryCodes(ctx, userID, old, strings.Join(recoveryCodes, ","))
+}
+
+func (o *AdminDatabase) SetLoginChallenge(ctx context.Context, challenge string, value *cache.LoginChallenge, expire time.Duration) error {
+	return o.loginChallenge.SetLoginChallenge(ctx, challenge, value, expire)
+}
+
+func (o *AdminDatabase) GetLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error) {
+	return o.loginChallenge.GetLoginChallenge(ctx, challenge)
+}
+
+func (o *AdminDatabase) IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error) {
+	return o.loginChallenge.IncrLoginChallengeAttempt(ctx, challenge)
+}
+
+func (o *AdminDatabase) DelLoginChallenge(ctx context.Context, challenge string) error {
+	return o.loginChallenge.DelLoginChallenge(ctx, challenge)
+}
+
+func (o *AdminDatabase) CountAdminLoginIP(ctx context.Context) (int64, error) {
+	return o.adminLoginIP.Count(ctx)
+}
+
+func (o *AdminDatabase) TakeAdminLoginIP(ctx context.Context, ip string) (*table.AdminLoginIP, error) {
+	return o.adminLoginIP.Take(ctx, ip)
+}
+
+func (o *AdminDatabase) SearchAdminLoginIP(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.AdminLoginIP, error) {
+	return o.adminLoginIP.Search(ctx, keyword, page, size)
+}
+
+func (o *AdminDatabase) AddAdminLoginIP(ctx context.Context, ms []*table.AdminLoginIP) error {
+	return o.adminLoginIP.Create(ctx, ms)
+}
+
+func (o *AdminDatabase) DelAdminLoginIP(ctx context.Context, ips []string) error {
+	return o.adminLoginIP.Delete(ctx, ips)
+}
+
+func (o *AdminDatabase) GetLoginLock(ctx context.Context, key string) (time.Duration, error) {
+	return o.loginLock.GetLoginLock(ctx, key)
+}
+
+func (o *AdminDatabase) AddLoginFail(ctx context.Context, key string, policy *cache.LoginLockPolicy) (time.Duration, error) {
+	return o.loginLock.AddLoginFail(ctx, key, policy)
+}
+
+func (o *AdminDatabase) DelLoginFail(ctx context.Context, key string) error {
+	return o.loginLock.DelLoginFail(ctx, key)
+}
+
+func (o *AdminDatabase) UnlockLogin(ctx context.Context, keys []string) error {
+	return o.loginLock.UnlockLogin(ctx, keys)
+}
+
+func (o *AdminDatabase) FindSigningKey(ctx context.Context) ([]*table.SigningKey, error) {
+	return o.signingKey.Find(ctx)
+}
+
+func (o *AdminDatabase) CreateSigningKey(ctx context.Context, ms []*table.SigningKey) error {
+	return o.signingKey.Create(ctx, ms)
+}
+
+func (o *AdminDatabase) DelSigningKey(ctx context.Context, kids []string) error {
+	return o.signingKey.Delete(ctx, kids)
+}
+
+func (o *AdminDatabase) CreateApiKey(ctx context.Context, ms []*table.ApiKey) error {
+	return o.apiKey.Create(ctx, ms)
+}
+
+func (o *AdminDatabase) TakeApiKey(ctx context.Context, keyID string) (*table.ApiKey, error) {
+	return o.apiKey.Take(ctx, keyID)
+}
+
+func (o *AdminDatabase) SearchApiKey(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.ApiKey, error) {
+	return o.apiKey.Search(ctx, keyword, page, size)
+}
+
+func (o *AdminDatabase) UpdateApiKey(ctx context.Context, keyID string, update map[string]any) error {
+	return o.apiKey.Update(ctx, keyID, update)
+}
+
+func (o *AdminDatabase) DelApiKey(ctx context.Context, keyIDs []string) error {
+	return o.apiKey.Delete(ctx, keyIDs)
+}
+
+func (o *AdminDatabase) CreateRole(ctx context.Context, ms []*table.Role) error {
+	return o.role.Create(ctx, ms)
+}
+
+func (o *AdminDatabase) TakeRole(ctx context.Context, roleID string) (*table.Role, error) {
+	return o.role.Take(ctx, roleID)
+}
+
+func (o *AdminDatabase) FindRole(ctx context.Context, roleIDs []string) ([]*table.Role, error) {
+	return o.role.Find(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) SearchRole(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.Role, error) {
+	return o.role.Search(ctx, keyword, page, size)
+}
+
+func (o *AdminDatabase) UpdateRole(ctx context.Context, roleID string, update map[string]any) error {
+	return o.role.Update(ctx, roleID, update)
+}
+
+func (o *AdminDatabase) DelRole(ctx context.Context, roleIDs []string) error {
+	return o.role.Delete(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) SetAdminRole(ctx context.Context, userID string, ms []*table.AdminRole) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.adminRole.NewTx(tx).DeleteUserID(ctx, []string{userID}); err != nil {
+			return err
+		}
+		return o.adminRole.NewTx(tx).Create(ctx, ms)
+	})
+}
+
+func (o *AdminDatabase) FindAdminRole(ctx context.Context, userIDs []string) ([]*table.AdminRole, error) {
+	return o.adminRole.FindUserID(ctx, userIDs)
+}
+
+func (o *AdminDatabase) FindRoleAdmin(ctx context.Context, roleIDs []string) ([]*table.AdminRole, error) {
+	return o.adminRole.FindRoleID(ctx, roleIDs)
+}
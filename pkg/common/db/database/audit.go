@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/audit"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	"github.com/OpenIMSDK/chat/pkg/common/db/model/admin"
+	table "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+)
+
+// appendAuditLogRetry every service appends to the same chain, a duplicate seq means another entry won the race.
+const appendAuditLogRetry = 10
+
+type AuditDatabaseInterface interface {
+	AppendAuditLog(ctx context.Context, e *table.AuditLog) error
+	SearchAuditLog(ctx context.Context, operator string, action string, keyword string, start time.Time, end time.Time, page int32, size int32) (uint32, []*table.AuditLog, error)
+	RangeAuditLog(ctx context.Context, fromSeq int64, limit int) ([]*table.AuditLog, error)
+}
+
+func NewAuditDatabase(db *gorm.DB) AuditDatabaseInterface {
+	return &AuditDatabase{auditLog: admin.NewAuditLog(db)}
+}
+
+type AuditDatabase struct {
+	auditLog table.AuditLogInterface
+}
+
+// AppendAuditLog sets the seq and hashes of the entry to continue the chain and saves it.
+func (o *AuditDatabase) AppendAuditLog(ctx context.Context, e *table.AuditLog) error {
+	for i := 0; ; i++ {
+		last, err := o.auditLog.Last(ctx)
+		if err == nil {
+			e.Seq = last.Seq + 1
+			e.PrevHash = last.Hash
+		} else if dbutil.IsGormNotFound(err) {
+			e.Seq = 1
+			e.PrevHash = ""
+		} else {
+			return err
+		}
+		e.Hash = audit.Hash(e.PrevHash, e)
+		err = o.auditLog.Create(ctx, e)
+		if err == nil || i == appendAuditLogRetry || !dbconn.IsMysqlDuplicateKey(errs.Unwrap(err)) {
+			return err
+		}
+	}
+}
+
+func (o *AuditDatabase) SearchAuditLog(ctx context.Context, operator string, action string, keyword string, start time.Time, end time.Time, page int32, size int32) (uint32, []*table.AuditLog, error) {
+	return o.auditLog.Search(ctx, operator, action, keyword, start, end, page, size)
+}
+
+func (o *AuditDatabase) RangeAuditLog(ctx context.Context, fromSeq int64, limit int) ([]*table.AuditLog, error) {
+	return o.auditLog.Range(ctx, fromSeq, limit)
+}
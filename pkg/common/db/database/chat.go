@@ -16,15 +16,18 @@ package database
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/model/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/db/model/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	table "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/tx"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
@@ -54,17 +57,68 @@ type ChatDatabaseInterface interface {
 	GetAttributeByEmail(ctx context.Context, email string) (*table.Attribute, error)
 	LoginRecord(ctx context.Context, record *table.UserLoginRecord, verifyCodeID *uint) error
 	UpdatePassword(ctx context.Context, userID string, password string) error
-	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, code uint) error
+	// ChangePassword also deletes the verify code when it is not nil and keeps the latest history passwords.
+	ChangePassword(ctx context.Context, userID string, password string, mustChange bool, history int, verifyCodeID *uint) error
+	FindPasswordHistory(ctx context.Context, userID string, limit int) ([]*table.PasswordHistory, error)
+	SetMustChangePassword(ctx context.Context, userIDs []string, mustChange bool) error
+	SetAccountDeletion(ctx context.Context, userID string, deletionTime *time.Time) error
+	FindAccountDeletion(ctx context.Context, before time.Time, last *table.Account, limit int) ([]*table.Account, error)
+	// DeleteUser deletes the account, attribute and all the records of the users.
+	DeleteUser(ctx context.Context, userIDs []string) error
+	TakeRegister(ctx context.Context, userID string) (*table.Register, error)
+	FindUserLogs(ctx context.Context, userID string) ([]*table.Log, error)
+	CreateDataExport(ctx context.Context, export *table.DataExport) error
+	TakeDataExport(ctx context.Context, exportID string) (*table.DataExport, error)
+	TakeLatestDataExport(ctx context.Context, userID string) (*table.DataExport, error)
+	FindDataExportID(ctx context.Context, userIDs []string) ([]string, error)
+	FinishDataExport(ctx context.Context, exportID string, status int32, errMsg string, size int64) error
+	DelExpiredDataExport(ctx context.Context, before time.Time, limit int) (int64, error)
 	NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountTotal(ctx context.Context, before *time.Time) (int64, error)
+	ExistLoginDevice(ctx context.Context, userID string, deviceID string) (bool, error)
+	FindLoginIP(ctx context.Context, userID string, limit int) ([]string, error)
+	SearchLoginRecord(ctx context.Context, userID string, platform string, ip string, deviceID string, start *time.Time, end *time.Time, pageNumber int32, showNumber int32) (uint32, []*table.UserLoginRecord, error)
+	DelLoginRecordBefore(ctx context.Context, before time.Time, limit int) (int64, error)
 	UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
 	UploadLogs(ctx context.Context, logs []*table.Log) error
 	DeleteLogs(ctx context.Context, logID []string, userID string) error
 	SearchLogs(ctx context.Context, keyword string, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*table.Log, error)
 	GetLogs(ctx context.Context, LogIDs []string, userID string) ([]*table.Log, error)
-}
-
-func NewChatDatabase(db *gorm.DB) ChatDatabaseInterface {
+	TakeTwoFactor(ctx context.Context, userID string) (*table.UserTwoFactor, error)
+	SetTwoFactorSecret(ctx context.Context, userID string, secret string) error
+	EnableTwoFactor(ctx context.Context, userID string, lastStep int64, recoveryCodes []string) error
+	UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+	UpdateTwoFactorRecoveryCodes(ctx context.Context, userID string, recoveryCodes []string) error
+	// UseTwoFactorRecoveryCode stores the remaining recovery codes, it reports false if the codes changed since old was read.
+	UseTwoFactorRecoveryCode(ctx context.Context, userID string, old string, recoveryCodes []string) (bool, error)
+	DelTwoFactor(ctx context.Context, userID string) error
+	SetLoginChallenge(ctx context.Context, challenge string, value *cache.LoginChallenge, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error)
+	IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error)
+	DelLoginChallenge(ctx context.Context, challenge string) error
+	GetLoginLock(ctx context.Context, key string) (time.Duration, error)
+	AddLoginFail(ctx context.Context, key string, policy *cache.LoginLockPolicy) (time.Duration, error)
+	DelLoginFail(ctx context.Context, key string) error
+	UnlockLogin(ctx context.Context, keys []string) error
+	SetLoginLink(ctx context.Context, nonce string, userID string, expire time.Duration) error
+	TakeLoginLink(ctx context.Context, nonce string) (string, error)
+	IncrLoginLinkSend(ctx context.Context, email string, window time.Duration) (int64, error)
+	SetCaptcha(ctx context.Context, captchaID string, typ string, answer string, expire time.Duration) error
+	TakeCaptcha(ctx context.Context, captchaID string) (string, string, error)
+	IncrVerifyCodeSend(ctx context.Context, key string, window time.Duration) (int64, error)
+	SetMailTemplate(ctx context.Context, template *table.MailTemplate) error
+	DelMailTemplate(ctx context.Context, purpose string, locale string) error
+	FindMailTemplate(ctx context.Context, purpose string) ([]*table.MailTemplate, error)
+	SetOIDCState(ctx context.Context, state string, value *cache.OIDCState, expire time.Duration) error
+	TakeOIDCState(ctx context.Context, state string) (*cache.OIDCState, error)
+	TakeExternalIdentity(ctx context.Context, provider string, subject string) (*table.ExternalIdentity, error)
+	CreateExternalIdentity(ctx context.Context, identity *table.ExternalIdentity) error
+	TakeUserExternalIdentity(ctx context.Context, provider string, userID string) (*table.ExternalIdentity, error)
+	FindExternalIdentity(ctx context.Context, provider string) ([]*table.ExternalIdentity, error)
+	UpdateExternalIdentityLogin(ctx context.Context, provider string, subject string, email string) error
+}
+
+func NewChatDatabase(db *gorm.DB, rdb redis.UniversalClient) ChatDatabaseInterface {
 	return &ChatDatabase{
 		tx:               tx.NewGorm(db),
 		register:         chat.NewRegister(db),
@@ -74,6 +128,16 @@ func NewChatDatabase(db *gorm.DB) ChatDatabaseInterface {
 		verifyCode:       chat.NewVerifyCode(db),
 		forbiddenAccount: admin2.NewForbiddenAccount(db),
 		log:              chat.NewLogs(db),
+		twoFactor:        chat.NewUserTwoFactor(db),
+		loginChallenge:   cache.NewLoginChallengeInterface(rdb),
+		loginLock:        cache.NewLoginLockInterface(rdb),
+		loginLink:        cache.NewLoginLinkInterface(rdb),
+		captcha:          cache.NewCaptchaInterface(rdb),
+		oidcState:        cache.NewOIDCStateInterface(rdb),
+		externalIdentity: chat.NewExternalIdentity(db),
+		passwordHistory:  chat.NewPasswordHistory(db),
+		dataExport:       chat.NewDataExport(db),
+		mailTemplate:     chat.NewMailTemplate(db),
 	}
 }
 
@@ -86,6 +150,16 @@ type ChatDatabase struct {
 	verifyCode       table.VerifyCodeInterface
 	forbiddenAccount admin.ForbiddenAccountInterface
 	log              table.LogInterface
+	twoFactor        table.UserTwoFactorInterface
+	loginChallenge   cache.LoginChallengeInterface
+	loginLock        cache.LoginLockInterface
+	loginLink        cache.LoginLinkInterface
+	captcha          cache.CaptchaInterface
+	oidcState        cache.OIDCStateInterface
+	externalIdentity table.ExternalIdentityInterface
+	passwordHistory  table.PasswordHistoryInterface
+	dataExport       table.DataExportInterface
+	mailTemplate     table.MailTemplateInterface
 }
 
 func (o *ChatDatabase) GetLogs(ctx context.Context, LogIDs []string, userID string) ([]*table.Log, error) {
@@ -105,7 +179,8 @@ func (o *ChatDatabase) UploadLogs(ctx context.Context, logs []*table.Log) error
 }
 
 func (o *ChatDatabase) IsNotFound(err error) bool {
-	return errs.Unwrap(err) == gorm.ErrRecordNotFound
+	err = errs.Unwrap(err)
+	return err == gorm.ErrRecordNotFound || err == redis.Nil
 }
 
 func (o *ChatDatabase) GetUser(ctx context.Context, userID string) (account *table.Account, err error) {
@@ -249,18 +324,112 @@ func (o *ChatDatabase) UpdatePassword(ctx context.Context, userID string, passwo
 	return o.account.UpdatePassword(ctx, userID, password)
 }
 
-func (o *ChatDatabase) UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, code uint) error {
+func (o *ChatDatabase) ChangePassword(ctx context.Context, userID string, password string, mustChange bool, history int, verifyCodeID *uint) error {
 	return o.tx.Transaction(func(tx any) error {
-		if err := o.account.NewTx(tx).UpdatePassword(ctx, userID, password); err != nil {
+		if err := o.account.NewTx(tx).ChangePassword(ctx, userID, password, mustChange); err != nil {
 			return err
 		}
-		if err := o.verifyCode.NewTx(tx).Delete(ctx, code); err != nil {
+		if verifyCodeID != nil {
+			if err := o.verifyCode.NewTx(tx).Delete(ctx, *verifyCodeID); err != nil {
+				return err
+			}
+		}
+		if history <= 0 {
+			return nil
+		}
+		passwordHistory := o.passwordHistory.NewTx(tx)
+		if err := passwordHistory.Create(ctx, &table.PasswordHistory{UserID: userID, Password: password, CreateTime: time.Now()}); err != nil {
 			return err
 		}
-		return nil
+		latest, err := passwordHistory.FindLatest(ctx, userID, history)
+		if err != nil {
+			return err
+		}
+		if len(latest) < history {
+			return nil
+		}
+		return passwordHistory.DeleteBefore(ctx, userID, latest[len(latest)-1].ID)
+	})
+}
+
+func (o *ChatDatabase) FindPasswordHistory(ctx context.Context, userID string, limit int) ([]*table.PasswordHistory, error) {
+	return o.passwordHistory.FindLatest(ctx, userID, limit)
+}
+
+func (o *ChatDatabase) SetMustChangePassword(ctx context.Context, userIDs []string, mustChange bool) error {
+	return o.account.SetMustChange(ctx, userIDs, mustChange)
+}
+
+func (o *ChatDatabase) SetAccountDeletion(ctx context.Context, userID string, deletionTime *time.Time) error {
+	return o.account.SetDeletionTime(ctx, userID, deletionTime)
+}
+
+func (o *ChatDatabase) FindAccountDeletion(ctx context.Context, before time.Time, last *table.Account, limit int) ([]*table.Account, error) {
+	return o.account.FindDeletion(ctx, before, last, limit)
+}
+
+func (o *ChatDatabase) DeleteUser(ctx context.Context, userIDs []string) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.attribute.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.register.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.userLoginRecord.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.log.NewTx(tx).DeleteUser(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.twoFactor.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.externalIdentity.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.passwordHistory.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		if err := o.dataExport.NewTx(tx).Delete(ctx, userIDs); err != nil {
+			return err
+		}
+		return o.account.NewTx(tx).Delete(ctx, userIDs)
 	})
 }
 
+func (o *ChatDatabase) TakeRegister(ctx context.Context, userID string) (*table.Register, error) {
+	return o.register.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) FindUserLogs(ctx context.Context, userID string) ([]*table.Log, error) {
+	return o.log.FindByUser(ctx, userID)
+}
+
+func (o *ChatDatabase) CreateDataExport(ctx context.Context, export *table.DataExport) error {
+	return o.dataExport.Create(ctx, export)
+}
+
+func (o *ChatDatabase) TakeDataExport(ctx context.Context, exportID string) (*table.DataExport, error) {
+	return o.dataExport.Take(ctx, exportID)
+}
+
+func (o *ChatDatabase) TakeLatestDataExport(ctx context.Context, userID string) (*table.DataExport, error) {
+	return o.dataExport.TakeLatest(ctx, userID)
+}
+
+func (o *ChatDatabase) FindDataExportID(ctx context.Context, userIDs []string) ([]string, error) {
+	return o.dataExport.FindID(ctx, userIDs)
+}
+
+func (o *ChatDatabase) FinishDataExport(ctx context.Context, exportID string, status int32, errMsg string, size int64) error {
+	return o.dataExport.Finish(ctx, exportID, status, errMsg, size)
+}
+
+func (o *ChatDatabase) DelExpiredDataExport(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return o.dataExport.DeleteExpired(ctx, before, limit)
+}
+
 func (o *ChatDatabase) NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error) {
 	return o.register.CountTotal(ctx, before)
 }
@@ -269,6 +438,22 @@ func (o *ChatDatabase) UserLoginCountTotal(ctx context.Context, before *time.Tim
 	return o.userLoginRecord.CountTotal(ctx, before)
 }
 
+func (o *ChatDatabase) ExistLoginDevice(ctx context.Context, userID string, deviceID string) (bool, error) {
+	return o.userLoginRecord.ExistDevice(ctx, userID, deviceID)
+}
+
+func (o *ChatDatabase) FindLoginIP(ctx context.Context, userID string, limit int) ([]string, error) {
+	return o.userLoginRecord.FindIP(ctx, userID, limit)
+}
+
+func (o *ChatDatabase) SearchLoginRecord(ctx context.Context, userID string, platform string, ip string, deviceID string, start *time.Time, end *time.Time, pageNumber int32, showNumber int32) (uint32, []*table.UserLoginRecord, error) {
+	return o.userLoginRecord.Search(ctx, userID, platform, ip, deviceID, start, end, pageNumber, showNumber)
+}
+
+func (o *ChatDatabase) DelLoginRecordBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	return o.userLoginRecord.DeleteBefore(ctx, before, limit)
+}
+
 func (o *ChatDatabase) UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error) {
 	return o.userLoginRecord.CountRangeEverydayTotal(ctx, start, end)
 }
@@ -276,3 +461,141 @@ func (o *ChatDatabase) UserLoginCountRangeEverydayTotal(ctx context.Context, sta
 func (o *ChatDatabase) FindAllUserID(ctx context.Context) ([]string, error) {
 	return o.account.FindAllUserID(ctx)
 }
+
+func (o *ChatDatabase) TakeTwoFactor(ctx context.Context, userID string) (*table.UserTwoFactor, error) {
+	return o.twoFactor.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) SetTwoFactorSecret(ctx context.Context, userID string, secret string) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.twoFactor.NewTx(tx).Delete(ctx, []string{userID}); err != nil {
+			return err
+		}
+		return o.twoFactor.NewTx(tx).Create(ctx, &table.UserTwoFactor{
+			UserID:     userID,
+			Secret:     secret,
+			CreateTime: time.Now(),
+		})
+	})
+}
+
+func (o *ChatDatabase) EnableTwoFactor(ctx context.Context, userID string, lastStep int64, recoveryCodes []string) error {
+	return o.twoFactor.Update(ctx, userID, map[string]any{
+		"enabled":        true,
+		"last_step":      lastStep,
+		"recovery_codes": strings.Join(recoveryCodes, ","),
+		"enable_time":    time.Now(),
+	})
+}
+
+func (o *ChatDatabase) UseTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.twoFactor.UpdateLastStep(ctx, userID, step)
+}
+
+func (o *ChatDatabase) UpdateTwoFactorRecoveryCodes(ctx context.Context, userID string, recoveryCodes []string) error {
+	return o.twoFactor.Update(ctx, userID, map[string]any{"recovery_codes": strings.Join(recoveryCodes, ",")})
+}
+
+func (o *ChatDatabase) UseTwoFactorRecoveryCode(ctx context.Context, userID string, old string, recoveryCodes []string) (bool, error) {
+	return o.twoFactor.CompareAndSetRecoveryCodes(ctx, userID, old, strings.Join(recoveryCodes, ","))
+}
+
+func (o *ChatDatabase) DelTwoFactor(ctx context.Context, userID string) error {
+	return o.twoFactor.Delete(ctx, []string{userID})
+}
+
+func (o *ChatDatabase) SetLoginChallenge(ctx context.Context, challenge string, value *cache.LoginChallenge, expire time.Duration) error {
+	return o.loginChallenge.SetLoginChallenge(ctx, challenge, value, expire)
+}
+
+func (o *ChatDatabase) GetLoginChallenge(ctx context.Context, challenge string) (*cache.LoginChallenge, error) {
+	return o.loginChallenge.GetLoginChallenge(ctx, challenge)
+}
+
+func (o *ChatDatabase) IncrLoginChallengeAttempt(ctx context.Context, challenge string) (int64, error) {
+	return o.loginChallenge.IncrLoginChallengeAttempt(ctx, challenge)
+}
+
+func (o *ChatDatabase) DelLoginChallenge(ctx context.Context, challenge string) error {
+	return o.loginChallenge.DelLoginChallenge(ctx, challenge)
+}
+
+func (o *ChatDatabase) GetLoginLock(ctx context.Context, key string) (time.Duration, error) {
+	return o.loginLock.GetLoginLock(ctx, key)
+}
+
+func (o *ChatDatabase) AddLoginFail(ctx context.Context, key string, policy *cache.LoginLockPolicy) (time.Duration, error) {
+	return o.loginLock.AddLoginFail(ctx, key, policy)
+}
+
+func (o *ChatDatabase) DelLoginFail(ctx context.Context, key string) error {
+	return o.loginLock.DelLoginFail(ctx, key)
+}
+
+func (o *ChatDatabase) UnlockLogin(ctx context.Context, keys []string) error {
+	return o.loginLock.UnlockLogin(ctx, keys)
+}
+
+func (o *ChatDatabase) SetLoginLink(ctx context.Context, nonce string, userID string, expire time.Duration) error {
+	return o.loginLink.SetLoginLink(ctx, nonce, userID, expire)
+}
+
+func (o *ChatDatabase) TakeLoginLink(ctx context.Context, nonce string) (string, error) {
+	return o.loginLink.TakeLoginLink(ctx, nonce)
+}
+
+func (o *ChatDatabase) IncrLoginLinkSend(ctx context.Context, email string, window time.Duration) (int64, error) {
+	return o.loginLink.IncrLoginLinkSend(ctx, email, window)
+}
+
+func (o *ChatDatabase) SetCaptcha(ctx context.Context, captchaID string, typ string, answer string, expire time.Duration) error {
+	return o.captcha.SetCaptcha(ctx, captchaID, typ, answer, expire)
+}
+
+func (o *ChatDatabase) TakeCaptcha(ctx context.Context, captchaID string) (string, string, error) {
+	return o.captcha.TakeCaptcha(ctx, captchaID)
+}
+
+func (o *ChatDatabase) IncrVerifyCodeSend(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.captcha.IncrVerifyCodeSend(ctx, key, window)
+}
+
+func (o *ChatDatabase) SetOIDCState(ctx context.Context, state string, value *cache.OIDCState, expire time.Duration) error {
+	return o.oidcState.SetOIDCState(ctx, state, value, expire)
+}
+
+func (o *ChatDatabase) TakeOIDCState(ctx context.Context, state string) (*cache.OIDCState, error) {
+	return o.oidcState.TakeOIDCState(ctx, state)
+}
+
+func (o *ChatDatabase) TakeExternalIdentity(ctx context.Context, provider string, subject string) (*table.ExternalIdentity, error) {
+	return o.externalIdentity.Take(ctx, provider, subject)
+}
+
+func (o *ChatDatabase) CreateExternalIdentity(ctx context.Context, identity *table.ExternalIdentity) error {
+	return o.externalIdentity.Create(ctx, identity)
+}
+
+func (o *ChatDatabase) TakeUserExternalIdentity(ctx context.Context, provider string, userID string) (*table.ExternalIdentity, error) {
+	return o.externalIdentity.TakeByUser(ctx, provider, userID)
+}
+
+func (o *ChatDatabase) FindExternalIdentity(ctx context.Context, provider string) ([]*table.ExternalIdentity, error) {
+	return o.externalIdentity.FindByProvider(ctx, provider)
+}
+
+func (o *ChatDatabase) UpdateExternalIdentityLogin(ctx context.Context, provider string, subject string, email string) error {
+	return o.externalIdentity.Update(ctx, provider, subject, map[string]any{"email": email, "last_login_time": time.Now()})
+}
+
+func (o *ChatDatabase) SetMailTemplate(ctx context.Context, template *table.MailTemplate) error {
+	return o.mailTemplate.Set(ctx, template)
+}
+
+func (o *ChatDatabase) DelMailTemplate(ctx context.Context, purpose string, locale string) error {
+	return o.mailTemplate.Delete(ctx, purpose, locale)
+}
+
+func (o *ChatDatabase) FindMailTemplate(ctx context.Context, purpose string) ([]*table.MailTemplate, error) {
+	return o.mailTemplate.Find(ctx, purpose)
+}
@@ -31,6 +31,7 @@ type OfficeDatabaseInterface interface {
 	GetUnreadWorkMomentsCount(ctx context.Context, userID string, time *time.Time) (int32, error)
 	GetUserReadTime(ctx context.Context, userID string) (*table.WorkMomentRead, error)
 	SetUserReadTime(ctx context.Context, userID string, time time.Time, setType int32) error
+	DeleteUserData(ctx context.Context, userID string) error
 }
 
 func NewOfficeDatabase(db *mongo.Database) (OfficeDatabaseInterface, error) {
@@ -153,3 +154,17 @@ func (o *OfficeDatabase) GetUserReadTime(ctx context.Context, userID string) (*t
 func (o *OfficeDatabase) SetUserReadTime(ctx context.Context, userID string, time time.Time, setType int32) error {
 	return o.read.Set(ctx, userID, time, setType)
 }
+
+// DeleteUserData deletes the tags, sent messages, moments, comments and likes of the users.
+func (o *OfficeDatabase) DeleteUserData(ctx context.Context, userID string) error {
+	if err := o.tag.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := o.sendTag.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	if err := o.workMoment.DeleteUser(ctx, userID); err != nil {
+		return err
+	}
+	return o.read.Delete(ctx, userID)
+}
@@ -29,6 +29,7 @@ type OrganizationDatabaseInterface interface {
 	CreateDepartmentMembers(ctx context.Context, departmentMembers []*table.DepartmentMember) error
 	DeleteDepartmentIDList(ctx context.Context, departmentIDList []string) error
 	DeleteDepartmentMemberByKey(ctx context.Context, userID string, departmentID string) error
+	DeleteDepartmentMemberByUserID(ctx context.Context, userIDs []string) error
 	UpdateDepartmentMember(ctx context.Context, departmentID string, userID string, update map[string]any) error
 	FindDepartmentMemberByUserID(ctx context.Context, userIDList []string) ([]*table.DepartmentMember, error)
 	GetDepartmentMemberByDepartmentID(ctx context.Context, departmentID string) ([]*table.DepartmentMember, error)
@@ -131,6 +132,10 @@ func (o *OrganizationDatabase) DeleteDepartmentMemberByKey(ctx context.Context,
 	return o.DepartmentMember.DeleteByKey(ctx, userID, departmentID)
 }
 
+func (o *OrganizationDatabase) DeleteDepartmentMemberByUserID(ctx context.Context, userIDs []string) error {
+	return o.DepartmentMember.DeleteByUserID(ctx, userIDs)
+}
+
 func (o *OrganizationDatabase) UpdateDepartmentMember(ctx context.Context, departmentID string, userID string, update map[string]any) error {
 	return o.DepartmentMember.Update(ctx, departmentID, userID, update)
 }
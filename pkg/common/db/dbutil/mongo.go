@@ -64,6 +64,14 @@ func MongoUpdateOne(ctx context.Context, collection *mongo.Collection, filter an
 	return nil
 }
 
+func MongoUpdateMany(ctx context.Context, collection *mongo.Collection, filter any, update any, opts ...*options.UpdateOptions) error {
+	_, err := collection.UpdateMany(ctx, filter, update, opts...)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
 func MongoFindUpdateOne[T any](ctx context.Context, collection *mongo.Collection, filter any, update any, opts ...*options.FindOneAndUpdateOptions) (*T, error) {
 	res := collection.FindOneAndUpdate(ctx, filter, update, opts...)
 	if err := res.Err(); err != nil {
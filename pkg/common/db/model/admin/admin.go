@@ -61,6 +61,24 @@ func (o *Admin) ChangePassword(ctx context.Context, userID string, newPassword s
 	return errs.Wrap(o.db.WithContext(ctx).Model(&admin.Admin{}).Where("user_id=?", userID).Update("password", newPassword).Error)
 }
 
+// UpdateTwoFactorStep moves two_factor_last_step forward, it reports false when the step has already been used.
+func (o *Admin) UpdateTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&admin.Admin{}).Where("user_id = ? and two_factor_last_step < ?", userID, step).Update("two_factor_last_step", step)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CompareAndSetRecoveryCodes replaces the recovery codes only if they are still old, so one code cannot be used twice.
+func (o *Admin) CompareAndSetRecoveryCodes(ctx context.Context, userID string, old string, recoveryCodes string) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&admin.Admin{}).Where("user_id = ? and recovery_codes = ?", userID, old).Update("recovery_codes", recoveryCodes)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
 func (o *Admin) Delete(ctx context.Context, userIDs []string) error {
 	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in ?", userIDs).Delete(&admin.Admin{}).Error)
 }
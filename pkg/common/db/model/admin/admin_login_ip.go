@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewAdminLoginIP(db *gorm.DB) admin.AdminLoginIPInterface {
+	return &AdminLoginIP{db: db}
+}
+
+type AdminLoginIP struct {
+	db *gorm.DB
+}
+
+func (o *AdminLoginIP) NewTx(tx any) admin.AdminLoginIPInterface {
+	return &AdminLoginIP{db: tx.(*gorm.DB)}
+}
+
+func (o *AdminLoginIP) Create(ctx context.Context, ms []*admin.AdminLoginIP) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *AdminLoginIP) Take(ctx context.Context, ip string) (*admin.AdminLoginIP, error) {
+	var m admin.AdminLoginIP
+	return &m, errs.Wrap(o.db.WithContext(ctx).Where("ip = ?", ip).Take(&m).Error)
+}
+
+func (o *AdminLoginIP) Count(ctx context.Context) (int64, error) {
+	var count int64
+	return count, errs.Wrap(o.db.WithContext(ctx).Model(&admin.AdminLoginIP{}).Count(&count).Error)
+}
+
+func (o *AdminLoginIP) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.AdminLoginIP, error) {
+	return ormutil.GormSearch[admin.AdminLoginIP](o.db.WithContext(ctx), []string{"ip", "remark"}, keyword, page, size)
+}
+
+func (o *AdminLoginIP) Delete(ctx context.Context, ips []string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("ip in ?", ips).Delete(&admin.AdminLoginIP{}).Error)
+}
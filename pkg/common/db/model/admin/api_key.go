@@ -0,0 +1,61 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewApiKey(db *gorm.DB) admin.ApiKeyInterface {
+	return &ApiKey{db: db}
+}
+
+type ApiKey struct {
+	db *gorm.DB
+}
+
+func (o *ApiKey) NewTx(tx any) admin.ApiKeyInterface {
+	return &ApiKey{db: tx.(*gorm.DB)}
+}
+
+func (o *ApiKey) Create(ctx context.Context, ms []*admin.ApiKey) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *ApiKey) Take(ctx context.Context, keyID string) (*admin.ApiKey, error) {
+	var m admin.ApiKey
+	return &m, errs.Wrap(o.db.WithContext(ctx).Where("key_id = ?", keyID).Take(&m).Error)
+}
+
+func (o *ApiKey) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.ApiKey, error) {
+	return ormutil.GormSearch[admin.ApiKey](o.db.WithContext(ctx), []string{"key_id", "name"}, keyword, page, size)
+}
+
+func (o *ApiKey) Update(ctx context.Context, keyID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Model(&admin.ApiKey{}).Where("key_id = ?", keyID).Updates(update).Error)
+}
+
+func (o *ApiKey) Delete(ctx context.Context, keyIDs []string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("key_id in ?", keyIDs).Delete(&admin.ApiKey{}).Error)
+}
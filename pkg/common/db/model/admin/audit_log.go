@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewAuditLog(db *gorm.DB) admin.AuditLogInterface {
+	return &AuditLog{db: db}
+}
+
+type AuditLog struct {
+	db *gorm.DB
+}
+
+func (o *AuditLog) Create(ctx context.Context, m *admin.AuditLog) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(m).Error)
+}
+
+func (o *AuditLog) Last(ctx context.Context) (*admin.AuditLog, error) {
+	var m admin.AuditLog
+	return &m, errs.Wrap(o.db.WithContext(ctx).Order("seq desc").Take(&m).Error)
+}
+
+func (o *AuditLog) Search(ctx context.Context, operator string, action string, keyword string, start time.Time, end time.Time, page int32, size int32) (uint32, []*admin.AuditLog, error) {
+	db := o.db.WithContext(ctx).Order("seq desc")
+	if operator != "" {
+		db = db.Where("operator = ?", operator)
+	}
+	if action != "" {
+		db = db.Where("action like concat('%',?,'%')", action)
+	}
+	if !start.IsZero() {
+		db = db.Where("create_time >= ?", start)
+	}
+	if !end.IsZero() {
+		db = db.Where("create_time < ?", end)
+	}
+	return ormutil.GormSearch[admin.AuditLog](db, []string{"target", "detail"}, keyword, page, size)
+}
+
+func (o *AuditLog) Range(ctx context.Context, fromSeq int64, limit int) ([]*admin.AuditLog, error) {
+	var ms []*admin.AuditLog
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("seq >= ?", fromSeq).Order("seq asc").Limit(limit).Find(&ms).Error)
+}
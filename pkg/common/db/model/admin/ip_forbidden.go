@@ -47,6 +47,11 @@ func (o *IPForbidden) Find(ctx context.Context, ips []string) ([]*admin.IPForbid
 	return forbiddens, errs.Wrap(o.db.WithContext(ctx).Where("ip in ?", ips).Find(&forbiddens).Error)
 }
 
+func (o *IPForbidden) FindAll(ctx context.Context) ([]*admin.IPForbidden, error) {
+	var forbiddens []*admin.IPForbidden
+	return forbiddens, errs.Wrap(o.db.WithContext(ctx).Find(&forbiddens).Error)
+}
+
 func (o *IPForbidden) Search(ctx context.Context, keyword string, state int32, page int32, size int32) (uint32, []*admin.IPForbidden, error) {
 	db := o.db.WithContext(ctx)
 	switch state {
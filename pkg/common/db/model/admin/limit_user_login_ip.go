@@ -53,6 +53,11 @@ func (o *LimitUserLoginIP) Take(ctx context.Context, userID string, ip string) (
 	return &f, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ? and ip = ?", userID, ip).Take(&f).Error)
 }
 
+func (o *LimitUserLoginIP) FindUserID(ctx context.Context, userID string) ([]*admin.LimitUserLoginIP, error) {
+	var ms []*admin.LimitUserLoginIP
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Find(&ms).Error)
+}
+
 func (o *LimitUserLoginIP) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.LimitUserLoginIP, error) {
 	return ormutil.GormSearch[admin.LimitUserLoginIP](o.db.WithContext(ctx), []string{"user_id", "ip"}, keyword, page, size)
 }
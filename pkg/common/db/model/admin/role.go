@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewRole(db *gorm.DB) admin.RoleInterface {
+	return &Role{db: db}
+}
+
+type Role struct {
+	db *gorm.DB
+}
+
+func (o *Role) NewTx(tx any) admin.RoleInterface {
+	return &Role{db: tx.(*gorm.DB)}
+}
+
+func (o *Role) Create(ctx context.Context, ms []*admin.Role) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *Role) Take(ctx context.Context, roleID string) (*admin.Role, error) {
+	var m admin.Role
+	return &m, errs.Wrap(o.db.WithContext(ctx).Where("role_id = ?", roleID).Take(&m).Error)
+}
+
+func (o *Role) Find(ctx context.Context, roleIDs []string) ([]*admin.Role, error) {
+	var ms []*admin.Role
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("role_id in ?", roleIDs).Find(&ms).Error)
+}
+
+func (o *Role) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.Role, error) {
+	return ormutil.GormSearch[admin.Role](o.db.WithContext(ctx), []string{"role_id", "name"}, keyword, page, size)
+}
+
+func (o *Role) Update(ctx context.Context, roleID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Model(&admin.Role{}).Where("role_id = ?", roleID).Updates(update).Error)
+}
+
+func (o *Role) Delete(ctx context.Context, roleIDs []string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("role_id in ?", roleIDs).Delete(&admin.Role{}).Error)
+}
+
+func NewAdminRole(db *gorm.DB) admin.AdminRoleInterface {
+	return &AdminRole{db: db}
+}
+
+type AdminRole struct {
+	db *gorm.DB
+}
+
+func (o *AdminRole) NewTx(tx any) admin.AdminRoleInterface {
+	return &AdminRole{db: tx.(*gorm.DB)}
+}
+
+func (o *AdminRole) Create(ctx context.Context, ms []*admin.AdminRole) error {
+	if len(ms) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *AdminRole) FindUserID(ctx context.Context, userIDs []string) ([]*admin.AdminRole, error) {
+	var ms []*admin.AdminRole
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("user_id in ?", userIDs).Find(&ms).Error)
+}
+
+func (o *AdminRole) FindRoleID(ctx context.Context, roleIDs []string) ([]*admin.AdminRole, error) {
+	var ms []*admin.AdminRole
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("role_id in ?", roleIDs).Find(&ms).Error)
+}
+
+func (o *AdminRole) DeleteUserID(ctx context.Context, userIDs []string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in ?", userIDs).Delete(&admin.AdminRole{}).Error)
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewSigningKey(db *gorm.DB) admin.SigningKeyInterface {
+	return &SigningKey{db: db}
+}
+
+type SigningKey struct {
+	db *gorm.DB
+}
+
+func (o *SigningKey) NewTx(tx any) admin.SigningKeyInterface {
+	return &SigningKey{db: tx.(*gorm.DB)}
+}
+
+func (o *SigningKey) Create(ctx context.Context, ms []*admin.SigningKey) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *SigningKey) Find(ctx context.Context) ([]*admin.SigningKey, error) {
+	var ms []*admin.SigningKey
+	return ms, errs.Wrap(o.db.WithContext(ctx).Order("create_time desc").Find(&ms).Error)
+}
+
+func (o *SigningKey) Delete(ctx context.Context, kids []string) error {
+	if len(kids) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("kid in ?", kids).Delete(&admin.SigningKey{}).Error)
+}
@@ -52,7 +52,35 @@ func (o *Account) UpdatePassword(ctx context.Context, userId string, password st
 	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Where("user_id = ?", userId).Updates(map[string]interface{}{"password": password, "change_time": time.Now()}).Error)
 }
 
+func (o *Account) ChangePassword(ctx context.Context, userID string, password string, mustChange bool) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Where("user_id = ?", userID).Updates(map[string]any{"password": password, "change_time": time.Now(), "must_change_password": mustChange}).Error)
+}
+
+func (o *Account) SetMustChange(ctx context.Context, userIDs []string, mustChange bool) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Where("user_id in ?", userIDs).Update("must_change_password", mustChange).Error)
+}
+
 func (o *Account) FindAllUserID(ctx context.Context) ([]string, error) {
 	var userIDs []string
 	return userIDs, errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Select("`user_id`").Find(&userIDs).Error)
 }
+
+func (o *Account) SetDeletionTime(ctx context.Context, userID string, deletionTime *time.Time) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Where("user_id = ?", userID).UpdateColumn("deletion_time", deletionTime).Error)
+}
+
+func (o *Account) FindDeletion(ctx context.Context, before time.Time, last *chat.Account, limit int) ([]*chat.Account, error) {
+	db := o.db.WithContext(ctx).Where("deletion_time < ?", before)
+	if last != nil && last.DeletionTime != nil {
+		db = db.Where("deletion_time > ? or (deletion_time = ? and user_id > ?)", *last.DeletionTime, *last.DeletionTime, last.UserID)
+	}
+	var accounts []*chat.Account
+	return accounts, errs.Wrap(db.Order("deletion_time, user_id").Limit(limit).Find(&accounts).Error)
+}
+
+func (o *Account) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.Account{}).Error)
+}
@@ -44,6 +44,13 @@ func (o *Attribute) Update(ctx context.Context, userID string, data map[string]a
 	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Attribute{}).Where("user_id = ?", userID).Updates(data).Error)
 }
 
+func (o *Attribute) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.Attribute{}).Error)
+}
+
 func (o *Attribute) Find(ctx context.Context, userIds []string) ([]*chat.Attribute, error) {
 	var a []*chat.Attribute
 	return a, errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIds).Find(&a).Error)
@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+)
+
+func NewDataExport(db *gorm.DB) chat.DataExportInterface {
+	return &DataExport{db: db}
+}
+
+type DataExport struct {
+	db *gorm.DB
+}
+
+func (o *DataExport) NewTx(tx any) chat.DataExportInterface {
+	return &DataExport{db: tx.(*gorm.DB)}
+}
+
+func (o *DataExport) Create(ctx context.Context, export *chat.DataExport) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(export).Error)
+}
+
+func (o *DataExport) Take(ctx context.Context, exportID string) (*chat.DataExport, error) {
+	var e chat.DataExport
+	return &e, errs.Wrap(o.db.WithContext(ctx).Where("export_id = ?", exportID).First(&e).Error)
+}
+
+func (o *DataExport) TakeLatest(ctx context.Context, userID string) (*chat.DataExport, error) {
+	var e chat.DataExport
+	return &e, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Order("create_time DESC").First(&e).Error)
+}
+
+func (o *DataExport) FindID(ctx context.Context, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	var exportIDs []string
+	return exportIDs, errs.Wrap(o.db.WithContext(ctx).Model(&chat.DataExport{}).Where("user_id in (?)", userIDs).Pluck("export_id", &exportIDs).Error)
+}
+
+func (o *DataExport) Finish(ctx context.Context, exportID string, status int32, errMsg string, size int64) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.DataExport{}).Where("export_id = ? and finish_time is null", exportID).Updates(map[string]any{
+		"status":      status,
+		"error":       errMsg,
+		"size":        size,
+		"finish_time": time.Now(),
+	}).Error)
+}
+
+func (o *DataExport) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	res := o.db.WithContext(ctx).Where("expire_time < ?", before).Limit(limit).Delete(&chat.DataExport{})
+	if res.Error != nil {
+		return 0, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+func (o *DataExport) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.DataExport{}).Error)
+}
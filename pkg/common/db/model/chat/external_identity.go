@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewExternalIdentity(db *gorm.DB) chat.ExternalIdentityInterface {
+	return &ExternalIdentity{db: db}
+}
+
+type ExternalIdentity struct {
+	db *gorm.DB
+}
+
+func (o *ExternalIdentity) NewTx(tx any) chat.ExternalIdentityInterface {
+	return &ExternalIdentity{db: tx.(*gorm.DB)}
+}
+
+func (o *ExternalIdentity) Create(ctx context.Context, identity *chat.ExternalIdentity) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(identity).Error)
+}
+
+func (o *ExternalIdentity) Take(ctx context.Context, provider string, subject string) (*chat.ExternalIdentity, error) {
+	var e chat.ExternalIdentity
+	return &e, errs.Wrap(o.db.WithContext(ctx).Where("provider = ? and subject = ?", provider, subject).First(&e).Error)
+}
+
+func (o *ExternalIdentity) TakeByUser(ctx context.Context, provider string, userID string) (*chat.ExternalIdentity, error) {
+	var e chat.ExternalIdentity
+	return &e, errs.Wrap(o.db.WithContext(ctx).Where("provider = ? and user_id = ?", provider, userID).First(&e).Error)
+}
+
+func (o *ExternalIdentity) FindByProvider(ctx context.Context, provider string) ([]*chat.ExternalIdentity, error) {
+	var es []*chat.ExternalIdentity
+	return es, errs.Wrap(o.db.WithContext(ctx).Where("provider = ?", provider).Find(&es).Error)
+}
+
+func (o *ExternalIdentity) Update(ctx context.Context, provider string, subject string, data map[string]any) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.ExternalIdentity{}).Where("provider = ? and subject = ?", provider, subject).Updates(data).Error)
+}
+
+func (o *ExternalIdentity) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.ExternalIdentity{}).Error)
+}
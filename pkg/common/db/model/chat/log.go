@@ -28,6 +28,10 @@ type Logs struct {
 	db *gorm.DB
 }
 
+func (l *Logs) NewTx(tx any) chat.LogInterface {
+	return &Logs{db: tx.(*gorm.DB)}
+}
+
 func (l *Logs) Create(ctx context.Context, log []*chat.Log) error {
 	return errs.Wrap(l.db.WithContext(ctx).Create(log).Error)
 }
@@ -47,6 +51,18 @@ func (l *Logs) Delete(ctx context.Context, logIDs []string, userID string) error
 	return errs.Wrap(l.db.WithContext(ctx).Where("log_id in ? and user_id=?", logIDs, userID).Delete(&chat.Log{}).Error)
 }
 
+func (l *Logs) DeleteUser(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(l.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.Log{}).Error)
+}
+
+func (l *Logs) FindByUser(ctx context.Context, userID string) ([]*chat.Log, error) {
+	var logs []*chat.Log
+	return logs, errs.Wrap(l.db.WithContext(ctx).Where("user_id = ?", userID).Order("create_time DESC").Find(&logs).Error)
+}
+
 func (l *Logs) Get(ctx context.Context, logIDs []string, userID string) ([]*chat.Log, error) {
 	var logs []*chat.Log
 	if userID == "" {
@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+)
+
+func NewMailTemplate(db *gorm.DB) chat.MailTemplateInterface {
+	return &MailTemplate{db: db}
+}
+
+type MailTemplate struct {
+	db *gorm.DB
+}
+
+func (o *MailTemplate) NewTx(tx any) chat.MailTemplateInterface {
+	return &MailTemplate{db: tx.(*gorm.DB)}
+}
+
+func (o *MailTemplate) Set(ctx context.Context, template *chat.MailTemplate) error {
+	return errs.Wrap(o.db.WithContext(ctx).Save(template).Error)
+}
+
+func (o *MailTemplate) Delete(ctx context.Context, purpose string, locale string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("purpose = ? and locale = ?", purpose, locale).Delete(&chat.MailTemplate{}).Error)
+}
+
+func (o *MailTemplate) Find(ctx context.Context, purpose string) ([]*chat.MailTemplate, error) {
+	db := o.db.WithContext(ctx)
+	if purpose != "" {
+		db = db.Where("purpose = ?", purpose)
+	}
+	var ts []*chat.MailTemplate
+	return ts, errs.Wrap(db.Order("purpose, locale").Find(&ts).Error)
+}
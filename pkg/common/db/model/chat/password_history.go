@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+)
+
+func NewPasswordHistory(db *gorm.DB) chat.PasswordHistoryInterface {
+	return &PasswordHistory{db: db}
+}
+
+type PasswordHistory struct {
+	db *gorm.DB
+}
+
+func (o *PasswordHistory) NewTx(tx any) chat.PasswordHistoryInterface {
+	return &PasswordHistory{db: tx.(*gorm.DB)}
+}
+
+func (o *PasswordHistory) Create(ctx context.Context, histories ...*chat.PasswordHistory) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&histories).Error)
+}
+
+func (o *PasswordHistory) FindLatest(ctx context.Context, userID string, limit int) ([]*chat.PasswordHistory, error) {
+	var histories []*chat.PasswordHistory
+	return histories, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Order("id DESC").Limit(limit).Find(&histories).Error)
+}
+
+func (o *PasswordHistory) DeleteBefore(ctx context.Context, userID string, id uint) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ? and id < ?", userID, id).Delete(&chat.PasswordHistory{}).Error)
+}
+
+func (o *PasswordHistory) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.PasswordHistory{}).Error)
+}
@@ -50,3 +50,15 @@ func (o *Register) CountTotal(ctx context.Context, before *time.Time) (count int
 	}
 	return count, nil
 }
+
+func (o *Register) Take(ctx context.Context, userID string) (*chat.Register, error) {
+	var r chat.Register
+	return &r, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).First(&r).Error)
+}
+
+func (o *Register) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.Register{}).Error)
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewUserTwoFactor(db *gorm.DB) chat.UserTwoFactorInterface {
+	return &UserTwoFactor{db: db}
+}
+
+type UserTwoFactor struct {
+	db *gorm.DB
+}
+
+func (o *UserTwoFactor) NewTx(tx any) chat.UserTwoFactorInterface {
+	return &UserTwoFactor{db: tx.(*gorm.DB)}
+}
+
+func (o *UserTwoFactor) Create(ctx context.Context, twoFactor *chat.UserTwoFactor) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(twoFactor).Error)
+}
+
+func (o *UserTwoFactor) Take(ctx context.Context, userID string) (*chat.UserTwoFactor, error) {
+	var t chat.UserTwoFactor
+	return &t, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).First(&t).Error)
+}
+
+func (o *UserTwoFactor) Update(ctx context.Context, userID string, data map[string]any) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.UserTwoFactor{}).Where("user_id = ?", userID).Updates(data).Error)
+}
+
+// UpdateLastStep moves last_step forward, it reports false when the step has already been used.
+func (o *UserTwoFactor) UpdateLastStep(ctx context.Context, userID string, lastStep int64) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&chat.UserTwoFactor{}).Where("user_id = ? and last_step < ?", userID, lastStep).Update("last_step", lastStep)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// CompareAndSetRecoveryCodes replaces the recovery codes only if they are still old, so one code cannot be used twice.
+func (o *UserTwoFactor) CompareAndSetRecoveryCodes(ctx context.Context, userID string, old string, recoveryCodes string) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&chat.UserTwoFactor{}).Where("user_id = ? and recovery_codes = ?", userID, old).Update("recovery_codes", recoveryCodes)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *UserTwoFactor) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.UserTwoFactor{}).Error)
+}
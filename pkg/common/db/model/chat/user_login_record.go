@@ -20,6 +20,7 @@ import (
 
 
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
 
 	"gorm.io/gorm"
 
@@ -78,3 +79,65 @@ func (o *UserLoginRecord) CountRangeEverydayTotal(ctx context.Context, start *ti
 	}
 	return v, loginCount, nil
 }
+
+func (o *UserLoginRecord) ExistDevice(ctx context.Context, userID string, deviceID string) (bool, error) {
+	var count int64
+	if err := o.db.WithContext(ctx).Model(&chat.UserLoginRecord{}).Where("user_id = ? and device_id = ?", userID, deviceID).Count(&count).Error; err != nil {
+		return false, errs.Wrap(err)
+	}
+	return count > 0, nil
+}
+
+func (o *UserLoginRecord) FindIP(ctx context.Context, userID string, limit int) ([]string, error) {
+	var ips []string
+	err := o.db.WithContext(ctx).
+		Model(&chat.UserLoginRecord{}).
+		Where("user_id = ?", userID).
+		Group("ip").
+		Order("MAX(login_time) DESC").
+		Limit(limit).
+		Pluck("ip", &ips).
+		Error
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return ips, nil
+}
+
+func (o *UserLoginRecord) Search(ctx context.Context, userID string, platform string, ip string, deviceID string, start *time.Time, end *time.Time, pageNumber int32, showNumber int32) (uint32, []*chat.UserLoginRecord, error) {
+	db := o.db.WithContext(ctx)
+	if userID != "" {
+		db = db.Where("user_id = ?", userID)
+	}
+	if platform != "" {
+		db = db.Where("platform = ?", platform)
+	}
+	if ip != "" {
+		db = db.Where("ip = ?", ip)
+	}
+	if deviceID != "" {
+		db = db.Where("device_id = ?", deviceID)
+	}
+	if start != nil {
+		db = db.Where("login_time >= ?", start)
+	}
+	if end != nil {
+		db = db.Where("login_time <= ?", end)
+	}
+	return ormutil.GormPage[chat.UserLoginRecord](db.Order("login_time DESC"), pageNumber, showNumber)
+}
+
+func (o *UserLoginRecord) DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	res := o.db.WithContext(ctx).Where("login_time < ?", before).Limit(limit).Delete(&chat.UserLoginRecord{})
+	if res.Error != nil {
+		return 0, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+func (o *UserLoginRecord) Delete(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id in (?)", userIDs).Delete(&chat.UserLoginRecord{}).Error)
+}
@@ -80,3 +80,7 @@ func (o *SendTagLogModel) Delete(ctx context.Context, ids []string) error {
 		},
 	})
 }
+
+func (o *SendTagLogModel) DeleteUser(ctx context.Context, userID string) error {
+	return dbutil.MongoDeleteMany(ctx, o.coll, bson.M{"send_user_id": userID})
+}
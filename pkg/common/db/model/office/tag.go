@@ -130,3 +130,11 @@ func (o *TagModel) Update(ctx context.Context, tagID, name string, addUserIDs []
 	}
 	return dbutil.MongoUpdateOne(ctx, o.coll, filter, update)
 }
+
+// DeleteUser deletes the tags created by the users and removes them from the other tags.
+func (o *TagModel) DeleteUser(ctx context.Context, userID string) error {
+	if err := dbutil.MongoDeleteMany(ctx, o.coll, bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+	return dbutil.MongoUpdateMany(ctx, o.coll, bson.M{"user_ids": userID}, bson.M{"$pull": bson.M{"user_ids": userID}})
+}
@@ -529,3 +529,27 @@ func (o *WorkMomentModel) FindRelevant(ctx context.Context, userID string, readT
 	}
 	return values, nil
 }
+
+// DeleteUser deletes the moments posted by the users and their comments and likes on other moments.
+func (o *WorkMomentModel) DeleteUser(ctx context.Context, userID string) error {
+	if err := dbutil.MongoDeleteMany(ctx, o.coll, bson.M{"user_id": userID}); err != nil {
+		return err
+	}
+	filter := bson.M{
+		"$or": []bson.M{
+			{"comments.user_id": userID},
+			{"like_users.user_id": userID},
+			{"at_user_ids": userID},
+			{"permission_user_ids": userID},
+		},
+	}
+	update := bson.M{
+		"$pull": bson.M{
+			"comments":            bson.M{"user_id": userID},
+			"like_users":          bson.M{"user_id": userID},
+			"at_user_ids":         userID,
+			"permission_user_ids": userID,
+		},
+	}
+	return dbutil.MongoUpdateMany(ctx, o.coll, filter, update)
+}
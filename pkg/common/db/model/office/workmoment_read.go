@@ -50,3 +50,7 @@ func (o *WorkMomentReadModel) Get(ctx context.Context, userID string) (*table.Wo
 		return nil, err
 	}
 }
+
+func (o *WorkMomentReadModel) Delete(ctx context.Context, userID string) error {
+	return dbutil.MongoDeleteOne(ctx, o.coll, bson.M{"_id": userID})
+}
@@ -47,6 +47,13 @@ func (o *DepartmentMember) DeleteByKey(ctx context.Context, userID, departmentID
 	return utils.Wrap(o.db.WithContext(ctx).Where("user_id = ? AND department_id = ?", userID, departmentID).Delete(&table.DepartmentMember{}).Error, "")
 }
 
+func (o *DepartmentMember) DeleteByUserID(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return utils.Wrap(o.db.WithContext(ctx).Where("user_id in ?", userIDs).Delete(&table.DepartmentMember{}).Error, "")
+}
+
 func (o *DepartmentMember) Update(ctx context.Context, departmentID string, userID string, update map[string]any) error {
 	return utils.Wrap(o.db.WithContext(ctx).Model(&table.DepartmentMember{}).Where("user_id = ? AND department_id = ?", userID, departmentID).Updates(update).Error, "")
 }
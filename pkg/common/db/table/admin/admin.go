@@ -22,12 +22,17 @@ import (
 // Admin 后台管理员.
 type Admin struct {
 	Account    string    `gorm:"column:account;primary_key;type:varchar(64)"`
-	Password   string    `gorm:"column:password;type:varchar(64)"`
+	Password   string    `gorm:"column:password;type:varchar(255)"`
 	FaceURL    string    `gorm:"column:face_url;type:varchar(255)"`
 	Nickname   string    `gorm:"column:nickname;type:varchar(64)"`
 	UserID     string    `gorm:"column:user_id;type:varchar(64)"` // openIM userID
 	Level      int32     `gorm:"column:level;default:1"`
 	CreateTime time.Time `gorm:"column:create_time"`
+
+	TwoFactorSecret   string `gorm:"column:two_factor_secret;type:varchar(64)"`
+	TwoFactorEnabled  bool   `gorm:"column:two_factor_enabled"`
+	TwoFactorLastStep int64  `gorm:"column:two_factor_last_step"`
+	RecoveryCodes     string `gorm:"column:recovery_codes;type:text"` // sha256 of unused recovery codes, comma separated
 }
 
 func (Admin) TableName() string {
@@ -40,6 +45,9 @@ type AdminInterface interface {
 	TakeUserID(ctx context.Context, userID string) (*Admin, error)
 	Update(ctx context.Context, account string, update map[string]any) error
 	ChangePassword(ctx context.Context, userID string, newPassword string) error
+	UpdateTwoFactorStep(ctx context.Context, userID string, step int64) (bool, error)
+	// CompareAndSetRecoveryCodes replaces the recovery codes only if they are still old, it reports false otherwise.
+	CompareAndSetRecoveryCodes(ctx context.Context, userID string, old string, recoveryCodes string) (bool, error)
 	Delete(ctx context.Context, userIDs []string) error
 	Search(ctx context.Context, page, size int32) (uint32, []*Admin, error)
 	InitAdmin(ctx context.Context) error
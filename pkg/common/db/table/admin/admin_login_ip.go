@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AdminLoginIP 管理员登录ip白名单, 为空时不限制.
+type AdminLoginIP struct {
+	IP         string    `gorm:"column:ip;primary_key;type:varchar(64)"`
+	Remark     string    `gorm:"column:remark;type:varchar(255)"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (AdminLoginIP) TableName() string {
+	return "admin_login_ips"
+}
+
+type AdminLoginIPInterface interface {
+	NewTx(tx any) AdminLoginIPInterface
+	Create(ctx context.Context, ms []*AdminLoginIP) error
+	Take(ctx context.Context, ip string) (*AdminLoginIP, error)
+	Count(ctx context.Context) (int64, error)
+	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*AdminLoginIP, error)
+	Delete(ctx context.Context, ips []string) error
+}
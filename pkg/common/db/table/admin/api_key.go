@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// ApiKey 服务间调用的密钥, 只保存密钥的哈希.
+type ApiKey struct {
+	KeyID         string    `gorm:"column:key_id;primary_key;type:varchar(64)"`
+	Name          string    `gorm:"column:name;type:varchar(64)"`
+	SecretHash    string    `gorm:"column:secret_hash;type:varchar(64)"`
+	Scopes        string    `gorm:"column:scopes;type:varchar(1024)"`    // 逗号分隔
+	AllowIPs      string    `gorm:"column:allow_ips;type:varchar(1024)"` // 逗号分隔的ip或cidr, 为空时不限制
+	ExpireTime    time.Time `gorm:"column:expire_time"`                  // 零值不过期
+	LastUsedTime  time.Time `gorm:"column:last_used_time"`
+	CreatorUserID string    `gorm:"column:creator_user_id;type:varchar(64)"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+}
+
+func (ApiKey) TableName() string {
+	return "api_keys"
+}
+
+type ApiKeyInterface interface {
+	NewTx(tx any) ApiKeyInterface
+	Create(ctx context.Context, ms []*ApiKey) error
+	Take(ctx context.Context, keyID string) (*ApiKey, error)
+	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*ApiKey, error)
+	Update(ctx context.Context, keyID string, update map[string]any) error
+	Delete(ctx context.Context, keyIDs []string) error
+}
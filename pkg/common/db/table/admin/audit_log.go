@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AuditLog 管理员操作的审计日志, 只追加, 每条记录包含上一条的哈希.
+type AuditLog struct {
+	Seq        int64     `gorm:"column:seq;primary_key;autoIncrement:false"`
+	Operator   string    `gorm:"column:operator;type:varchar(64);index"`
+	Action     string    `gorm:"column:action;type:varchar(128);index"`
+	Target     string    `gorm:"column:target;type:varchar(1024)"`
+	Detail     string    `gorm:"column:detail;type:text"` // 请求内容, 敏感字段已脱敏
+	IP         string    `gorm:"column:ip;type:varchar(64)"`
+	Error      string    `gorm:"column:error;type:varchar(1024)"` // 为空表示成功
+	CreateTime time.Time `gorm:"column:create_time;index"`
+	PrevHash   string    `gorm:"column:prev_hash;type:varchar(64)"`
+	Hash       string    `gorm:"column:hash;type:varchar(64)"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+type AuditLogInterface interface {
+	Create(ctx context.Context, m *AuditLog) error
+	Last(ctx context.Context) (*AuditLog, error)
+	Search(ctx context.Context, operator string, action string, keyword string, start time.Time, end time.Time, page int32, size int32) (uint32, []*AuditLog, error)
+	Range(ctx context.Context, fromSeq int64, limit int) ([]*AuditLog, error)
+}
@@ -19,12 +19,13 @@ import (
 	"time"
 )
 
-// 禁止ip登录 注册.
+// 禁止ip登录 注册, ip可以是单个ip或cidr.
 type IPForbidden struct {
-	IP            string    `gorm:"column:ip;primary_key;type:char(32)"`
-	LimitRegister bool      `gorm:"column:limit_register"`
-	LimitLogin    bool      `gorm:"column:limit_login"`
-	CreateTime    time.Time `gorm:"column:create_time"`
+	IP            string     `gorm:"column:ip;primary_key;type:varchar(64)"`
+	LimitRegister bool       `gorm:"column:limit_register"`
+	LimitLogin    bool       `gorm:"column:limit_login"`
+	CreateTime    time.Time  `gorm:"column:create_time"`
+	ExpireTime    *time.Time `gorm:"column:expire_time"` // 为空不过期
 }
 
 func (IPForbidden) IPForbidden() string {
@@ -35,6 +36,7 @@ type IPForbiddenInterface interface {
 	NewTx(tx any) IPForbiddenInterface
 	Take(ctx context.Context, ip string) (*IPForbidden, error)
 	Find(ctx context.Context, ips []string) ([]*IPForbidden, error)
+	FindAll(ctx context.Context) ([]*IPForbidden, error)
 	Search(ctx context.Context, keyword string, state int32, page int32, size int32) (uint32, []*IPForbidden, error)
 	Create(ctx context.Context, ms []*IPForbidden) error
 	Delete(ctx context.Context, ips []string) error
@@ -19,11 +19,12 @@ import (
 	"time"
 )
 
-// 限制userID只能在某些ip登录.
+// 限制userID只能在某些ip登录, ip可以是单个ip或cidr.
 type LimitUserLoginIP struct {
-	UserID     string    `gorm:"column:user_id;primary_key;type:char(64)"`
-	IP         string    `gorm:"column:ip;primary_key;type:char(32)"`
-	CreateTime time.Time `gorm:"column:create_time" `
+	UserID     string     `gorm:"column:user_id;primary_key;type:char(64)"`
+	IP         string     `gorm:"column:ip;primary_key;type:varchar(64)"`
+	CreateTime time.Time  `gorm:"column:create_time" `
+	ExpireTime *time.Time `gorm:"column:expire_time"` // 为空不过期
 }
 
 func (LimitUserLoginIP) TableName() string {
@@ -35,5 +36,6 @@ type LimitUserLoginIPInterface interface {
 	Delete(ctx context.Context, ms []*LimitUserLoginIP) error
 	Count(ctx context.Context, userID string) (uint32, error)
 	Take(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
+	FindUserID(ctx context.Context, userID string) ([]*LimitUserLoginIP, error)
 	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*LimitUserLoginIP, error)
 }
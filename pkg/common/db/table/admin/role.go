@@ -0,0 +1,62 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// Role 后台管理员角色.
+type Role struct {
+	RoleID      string    `gorm:"column:role_id;primary_key;type:varchar(64)"`
+	Name        string    `gorm:"column:name;type:varchar(64)"`
+	Permissions string    `gorm:"column:permissions;type:varchar(1024)"` // 逗号分隔
+	Description string    `gorm:"column:description;type:varchar(255)"`
+	CreateTime  time.Time `gorm:"column:create_time"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+type RoleInterface interface {
+	NewTx(tx any) RoleInterface
+	Create(ctx context.Context, ms []*Role) error
+	Take(ctx context.Context, roleID string) (*Role, error)
+	Find(ctx context.Context, roleIDs []string) ([]*Role, error)
+	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*Role, error)
+	Update(ctx context.Context, roleID string, update map[string]any) error
+	Delete(ctx context.Context, roleIDs []string) error
+}
+
+// AdminRole 管理员拥有的角色.
+type AdminRole struct {
+	UserID     string    `gorm:"column:user_id;primary_key;type:varchar(64)"`
+	RoleID     string    `gorm:"column:role_id;primary_key;type:varchar(64)"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (AdminRole) TableName() string {
+	return "admin_roles"
+}
+
+type AdminRoleInterface interface {
+	NewTx(tx any) AdminRoleInterface
+	Create(ctx context.Context, ms []*AdminRole) error
+	FindUserID(ctx context.Context, userIDs []string) ([]*AdminRole, error)
+	FindRoleID(ctx context.Context, roleIDs []string) ([]*AdminRole, error)
+	DeleteUserID(ctx context.Context, userIDs []string) error
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// SigningKey 令牌签名密钥, 最新的密钥签名, 被替换的密钥在宽限期内仍可校验.
+type SigningKey struct {
+	KID        string    `gorm:"column:kid;primary_key;type:varchar(64)"`
+	Algorithm  string    `gorm:"column:algorithm;type:varchar(16)"`
+	PrivateKey string    `gorm:"column:private_key;type:text"`
+	CreateTime time.Time `gorm:"column:create_time;index:create_time"`
+}
+
+func (SigningKey) TableName() string {
+	return "signing_keys"
+}
+
+type SigningKeyInterface interface {
+	NewTx(tx any) SigningKeyInterface
+	Create(ctx context.Context, ms []*SigningKey) error
+	// Find returns the keys, newest first.
+	Find(ctx context.Context) ([]*SigningKey, error)
+	Delete(ctx context.Context, kids []string) error
+}
@@ -21,11 +21,13 @@ import (
 
 // Account 账号密码表.
 type Account struct {
-	UserID         string    `gorm:"column:user_id;primary_key;type:char(64)"`
-	Password       string    `gorm:"column:password;type:varchar(32)"`
-	CreateTime     time.Time `gorm:"column:create_time;autoCreateTime"`
-	ChangeTime     time.Time `gorm:"column:change_time;autoUpdateTime"`
-	OperatorUserID string    `gorm:"column:operator_user_id;type:varchar(64)"`
+	UserID         string     `gorm:"column:user_id;primary_key;type:char(64)"`
+	Password       string     `gorm:"column:password;type:varchar(255)"` // bcrypt, rows not yet upgraded hold the client md5
+	CreateTime     time.Time  `gorm:"column:create_time;autoCreateTime"`
+	ChangeTime     time.Time  `gorm:"column:change_time;autoUpdateTime"`
+	OperatorUserID string     `gorm:"column:operator_user_id;type:varchar(64)"`
+	MustChange     bool       `gorm:"column:must_change_password;default:false"` // the next login only gets a token to change the password
+	DeletionTime   *time.Time `gorm:"column:deletion_time;index"`                // the account is purged after this time, nil if no deletion is scheduled
 }
 
 func (Account) TableName() string {
@@ -38,5 +40,13 @@ type AccountInterface interface {
 	Take(ctx context.Context, userId string) (*Account, error)
 	Update(ctx context.Context, userID string, data map[string]any) error
 	UpdatePassword(ctx context.Context, userId string, password string) error
+	// ChangePassword sets a new password chosen by the user or an admin and the must change flag.
+	ChangePassword(ctx context.Context, userID string, password string, mustChange bool) error
+	SetMustChange(ctx context.Context, userIDs []string, mustChange bool) error
 	FindAllUserID(ctx context.Context) ([]string, error)
+	// SetDeletionTime schedules the deletion of the account, nil cancels it.
+	SetDeletionTime(ctx context.Context, userID string, deletionTime *time.Time) error
+	// FindDeletion returns at most limit accounts whose deletion time is before, ordered by deletion time and user id and starting after the account last, nil for the first page.
+	FindDeletion(ctx context.Context, before time.Time, last *Account, limit int) ([]*Account, error)
+	Delete(ctx context.Context, userIDs []string) error
 }
@@ -38,6 +38,7 @@ type Attribute struct {
 	AllowAddFriend   int32     `gorm:"column:allow_add_friend;default:1"`
 	GlobalRecvMsgOpt int32     `gorm:"column:global_recv_msg_opt;default:0"`
 	RegisterType     int32     `gorm:"column:register_type"`
+	Language         string    `gorm:"column:language;type:varchar(16)"` // picks the locale of the mails
 	// tob
 	EnglishName string `gorm:"column:english_name;size:256"`
 	Station     string `gorm:"column:station;size:256"`
@@ -53,6 +54,7 @@ type AttributeInterface interface {
 	NewTx(tx any) AttributeInterface
 	Create(ctx context.Context, attribute ...*Attribute) error
 	Update(ctx context.Context, userID string, data map[string]any) error
+	Delete(ctx context.Context, userIDs []string) error
 	Find(ctx context.Context, userIds []string) ([]*Attribute, error)
 	FindAccount(ctx context.Context, accounts []string) ([]*Attribute, error)
 	Search(ctx context.Context, keyword string, genders []int32, page int32, size int32) (uint32, []*Attribute, error)
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// DataExport is an export of the data of a user, the zip is kept in dataExport.dir.
+type DataExport struct {
+	ExportID       string     `gorm:"column:export_id;primary_key;type:char(32)"`
+	UserID         string     `gorm:"column:user_id;type:char(64);index"`
+	OperatorUserID string     `gorm:"column:operator_user_id;type:varchar(64)"`
+	Status         int32      `gorm:"column:status"`
+	Error          string     `gorm:"column:error;type:varchar(255)"`
+	Size           int64      `gorm:"column:size"`
+	CreateTime     time.Time  `gorm:"column:create_time"`
+	FinishTime     *time.Time `gorm:"column:finish_time"`
+	ExpireTime     time.Time  `gorm:"column:expire_time;index"` // the export is deleted after this time
+}
+
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+type DataExportInterface interface {
+	NewTx(tx any) DataExportInterface
+	Create(ctx context.Context, export *DataExport) error
+	Take(ctx context.Context, exportID string) (*DataExport, error)
+	// TakeLatest returns the latest export of the user.
+	TakeLatest(ctx context.Context, userID string) (*DataExport, error)
+	// FindID returns the ids of all exports of the users.
+	FindID(ctx context.Context, userIDs []string) ([]string, error)
+	// Finish sets the result of the export unless it has already been finished.
+	Finish(ctx context.Context, exportID string, status int32, errMsg string, size int64) error
+	// DeleteExpired deletes at most limit exports whose expire time is before.
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
+	Delete(ctx context.Context, userIDs []string) error
+}
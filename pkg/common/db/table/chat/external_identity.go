@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// ExternalIdentity 第三方身份提供方账号与用户的绑定.
+type ExternalIdentity struct {
+	Provider      string    `gorm:"column:provider;primary_key;type:varchar(64)"`
+	Subject       string    `gorm:"column:subject;primary_key;type:varchar(255)"`
+	UserID        string    `gorm:"column:user_id;index;type:char(64)"`
+	Email         string    `gorm:"column:email;type:varchar(255)"`
+	LastLoginTime time.Time `gorm:"column:last_login_time"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+func (ExternalIdentity) TableName() string {
+	return "external_identities"
+}
+
+type ExternalIdentityInterface interface {
+	NewTx(tx any) ExternalIdentityInterface
+	Create(ctx context.Context, identity *ExternalIdentity) error
+	Take(ctx context.Context, provider string, subject string) (*ExternalIdentity, error)
+	TakeByUser(ctx context.Context, provider string, userID string) (*ExternalIdentity, error)
+	FindByProvider(ctx context.Context, provider string) ([]*ExternalIdentity, error)
+	Update(ctx context.Context, provider string, subject string, data map[string]any) error
+	Delete(ctx context.Context, userIDs []string) error
+}
@@ -32,8 +32,11 @@ type Log struct {
 }
 
 type LogInterface interface {
+	NewTx(tx any) LogInterface
 	Create(ctx context.Context, log []*Log) error
 	Search(ctx context.Context, keyword string, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*Log, error)
 	Delete(ctx context.Context, logID []string, userID string) error
 	Get(ctx context.Context, logIDs []string, userID string) ([]*Log, error)
+	DeleteUser(ctx context.Context, userIDs []string) error
+	FindByUser(ctx context.Context, userID string) ([]*Log, error)
 }
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// MailTemplate 管理员修改的邮件模板, 覆盖同用途同语言的内置模板.
+type MailTemplate struct {
+	Purpose        string    `gorm:"column:purpose;primary_key;type:varchar(32)"`
+	Locale         string    `gorm:"column:locale;primary_key;type:varchar(16)"`
+	Subject        string    `gorm:"column:subject;type:varchar(255)"`
+	HTML           string    `gorm:"column:html;type:text"`
+	Text           string    `gorm:"column:text;type:text"`
+	OperatorUserID string    `gorm:"column:operator_user_id;type:varchar(64)"`
+	UpdateTime     time.Time `gorm:"column:update_time"`
+}
+
+func (MailTemplate) TableName() string {
+	return "mail_templates"
+}
+
+type MailTemplateInterface interface {
+	NewTx(tx any) MailTemplateInterface
+	// Set creates the template or replaces the one of the purpose and locale.
+	Set(ctx context.Context, template *MailTemplate) error
+	Delete(ctx context.Context, purpose string, locale string) error
+	// Find returns the templates of the purpose, every template if purpose is empty.
+	Find(ctx context.Context, purpose string) ([]*MailTemplate, error)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordHistory 历史密码表, 用于禁止重复使用最近的密码.
+type PasswordHistory struct {
+	ID         uint      `gorm:"column:id;primary_key;autoIncrement"`
+	UserID     string    `gorm:"column:user_id;type:char(64);index"`
+	Password   string    `gorm:"column:password;type:varchar(255)"` // bcrypt
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
+
+type PasswordHistoryInterface interface {
+	NewTx(tx any) PasswordHistoryInterface
+	Create(ctx context.Context, histories ...*PasswordHistory) error
+	// FindLatest returns the latest passwords of the user first.
+	FindLatest(ctx context.Context, userID string, limit int) ([]*PasswordHistory, error)
+	// DeleteBefore deletes the passwords of the user older than the one with id.
+	DeleteBefore(ctx context.Context, userID string, id uint) error
+	Delete(ctx context.Context, userIDs []string) error
+}
@@ -38,4 +38,6 @@ type RegisterInterface interface {
 	NewTx(tx any) RegisterInterface
 	Create(ctx context.Context, registers ...*Register) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
+	Take(ctx context.Context, userID string) (*Register, error)
+	Delete(ctx context.Context, userIDs []string) error
 }
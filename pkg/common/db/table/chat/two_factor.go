@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// UserTwoFactor 用户两步验证表.
+type UserTwoFactor struct {
+	UserID        string    `gorm:"column:user_id;primary_key;type:char(64)"`
+	Secret        string    `gorm:"column:secret;type:varchar(64)"`
+	Enabled       bool      `gorm:"column:enabled"`
+	LastStep      int64     `gorm:"column:last_step"`
+	RecoveryCodes string    `gorm:"column:recovery_codes;type:text"` // sha256 of unused recovery codes, comma separated
+	EnableTime    time.Time `gorm:"column:enable_time"`
+	CreateTime    time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+func (UserTwoFactor) TableName() string {
+	return "user_two_factors"
+}
+
+type UserTwoFactorInterface interface {
+	NewTx(tx any) UserTwoFactorInterface
+	Create(ctx context.Context, twoFactor *UserTwoFactor) error
+	Take(ctx context.Context, userID string) (*UserTwoFactor, error)
+	Update(ctx context.Context, userID string, data map[string]any) error
+	UpdateLastStep(ctx context.Context, userID string, lastStep int64) (bool, error)
+	// CompareAndSetRecoveryCodes replaces the recovery codes only if they are still old, it reports false otherwise.
+	CompareAndSetRecoveryCodes(ctx context.Context, userID string, old string, recoveryCodes string) (bool, error)
+	Delete(ctx context.Context, userIDs []string) error
+}
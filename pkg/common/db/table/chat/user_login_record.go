@@ -21,11 +21,12 @@ import (
 
 // 用户登录信息表.
 type UserLoginRecord struct {
-	UserID    string    `gorm:"column:user_id;size:64"`
-	LoginTime time.Time `gorm:"column:login_time"`
+	UserID    string    `gorm:"column:user_id;size:64;index"`
+	LoginTime time.Time `gorm:"column:login_time;index"`
 	IP        string    `gorm:"column:ip;type:varchar(32)"`
 	DeviceID  string    `gorm:"column:device_id;type:varchar(255)"`
 	Platform  string    `gorm:"column:platform;type:varchar(32)"`
+	Location  string    `gorm:"column:location;type:varchar(255)"`
 }
 
 func (UserLoginRecord) TableName() string {
@@ -37,4 +38,12 @@ type UserLoginRecordInterface interface {
 	Create(ctx context.Context, records ...*UserLoginRecord) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	CountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
+	ExistDevice(ctx context.Context, userID string, deviceID string) (bool, error)
+	// FindIP returns the distinct ips of the latest logins of the user.
+	FindIP(ctx context.Context, userID string, limit int) ([]string, error)
+	// Search returns the latest records first, empty filters and nil times match everything.
+	Search(ctx context.Context, userID string, platform string, ip string, deviceID string, start *time.Time, end *time.Time, pageNumber int32, showNumber int32) (uint32, []*UserLoginRecord, error)
+	// DeleteBefore deletes at most limit records older than before.
+	DeleteBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+	Delete(ctx context.Context, userIDs []string) error
 }
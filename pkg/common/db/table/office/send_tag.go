@@ -22,4 +22,5 @@ type SendTagLogInterface interface {
 	FindSendUserID(ctx context.Context, ids []string) (map[string]string, error)
 	Delete(ctx context.Context, ids []string) error
 	Create(ctx context.Context, tag *TagSendLog) error
+	DeleteUser(ctx context.Context, userID string) error
 }
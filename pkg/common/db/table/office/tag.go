@@ -27,4 +27,5 @@ type TagInterface interface {
 	Find(ctx context.Context, tagIDs []string) ([]*Tag, error)
 	Delete(ctx context.Context, tagID string) error
 	Update(ctx context.Context, tagID, name string, addUserIDs []string, delUserIDs []string, addGroupIDs []string, delGroupIDs []string) error
+	DeleteUser(ctx context.Context, userID string) error
 }
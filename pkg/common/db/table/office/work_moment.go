@@ -55,4 +55,5 @@ type WorkMomentInterface interface {
 	GetUserRecv(ctx context.Context, userID string, friendIDs []string, showNumber, pageNumber int32) ([]*WorkMoment, error)
 	FindRelevant(ctx context.Context, userID string, time *time.Time, showNumber, pageNumber int32) ([]*WorkMoment, error)
 	GetUnreadCount(ctx context.Context, userID string, time *time.Time) (int32, error)
+	DeleteUser(ctx context.Context, userID string) error
 }
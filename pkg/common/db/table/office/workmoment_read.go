@@ -14,4 +14,5 @@ type WorkMomentRead struct {
 type WorkMomentReadInterface interface {
 	Set(ctx context.Context, userID string, time time.Time, setType int32) error
 	Get(ctx context.Context, userID string) (*WorkMomentRead, error)
+	Delete(ctx context.Context, userID string) error
 }
@@ -23,6 +23,7 @@ type DepartmentMemberInterface interface {
 	Creates(ctx context.Context, m []*DepartmentMember) error
 	Get(ctx context.Context, userID string) ([]*DepartmentMember, error)
 	DeleteByKey(ctx context.Context, userID string, departmentID string) error
+	DeleteByUserID(ctx context.Context, userIDs []string) error
 	Update(ctx context.Context, departmentID string, userID string, update map[string]any) error
 	FindByUserID(ctx context.Context, userIDList []string) ([]*DepartmentMember, error)
 	GetByDepartmentID(ctx context.Context, departmentID string) ([]*DepartmentMember, error)
@@ -0,0 +1,317 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package directory reads users and organizational units from an LDAP or Active Directory server.
+package directory
+
+import (
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+// Provider is the provider name of the external identities of directory users.
+const Provider = "ldap"
+
+// departmentIDPrefix marks the departments mirrored from the directory.
+const departmentIDPrefix = "ldap_"
+
+type Attributes struct {
+	ID          string
+	Account     string
+	Nickname    string
+	Email       string
+	Telephone   string
+	Station     string
+	EnglishName string
+	Department  string
+}
+
+type Config struct {
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	BaseDN             string
+	UserFilter         string
+	DepartmentFilter   string
+	Attributes         Attributes
+}
+
+type User struct {
+	DN          string
+	ID          string
+	Account     string
+	Nickname    string
+	Email       string
+	Telephone   string
+	Station     string
+	EnglishName string
+}
+
+type Department struct {
+	DN   string
+	Name string
+}
+
+type Directory struct {
+	conf Config
+}
+
+func New(conf Config) *Directory {
+	attr := &conf.Attributes
+	setDefault := func(v *string, def string) {
+		if *v == "" {
+			*v = def
+		}
+	}
+	setDefault(&conf.UserFilter, "(objectClass=person)")
+	setDefault(&conf.DepartmentFilter, "(objectClass=organizationalUnit)")
+	setDefault(&attr.ID, "entryUUID")
+	setDefault(&attr.Account, "uid")
+	setDefault(&attr.Nickname, "cn")
+	setDefault(&attr.Email, "mail")
+	setDefault(&attr.Department, "ou")
+	return &Directory{conf: conf}
+}
+
+// NewFromConfig returns the directory of the ldap config, nil if it is disabled.
+func NewFromConfig() *Directory {
+	c := config.Config.LDAP
+	if !c.Enable {
+		return nil
+	}
+	return New(Config{
+		URL:                c.URL,
+		StartTLS:           c.StartTLS,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+		BindDN:             c.BindDN,
+		BindPassword:       c.BindPassword,
+		BaseDN:             c.BaseDN,
+		UserFilter:         c.UserFilter,
+		DepartmentFilter:   c.DepartmentFilter,
+		Attributes: Attributes{
+			ID:          c.Attributes.ID,
+			Account:     c.Attributes.Account,
+			Nickname:    c.Attributes.Nickname,
+			Email:       c.Attributes.Email,
+			Telephone:   c.Attributes.Telephone,
+			Station:     c.Attributes.Station,
+			EnglishName: c.Attributes.EnglishName,
+			Department:  c.Attributes.Department,
+		},
+	})
+}
+
+func (d *Directory) dial() (*ldap.Conn, error) {
+	tlsConf := &tls.Config{InsecureSkipVerify: d.conf.InsecureSkipVerify}
+	conn, err := ldap.DialURL(d.conf.URL, ldap.DialWithTLSConfig(tlsConf))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if d.conf.StartTLS {
+		if err := conn.StartTLS(tlsConf); err != nil {
+			conn.Close()
+			return nil, errs.Wrap(err)
+		}
+	}
+	if d.conf.BindDN != "" {
+		if err := conn.Bind(d.conf.BindDN, d.conf.BindPassword); err != nil {
+			conn.Close()
+			return nil, errs.Wrap(err, "bind service account")
+		}
+	}
+	return conn, nil
+}
+
+func (d *Directory) userAttributes() []string {
+	a := d.conf.Attributes
+	var attrs []string
+	for _, v := range []string{a.ID, a.Account, a.Nickname, a.Email, a.Telephone, a.Station, a.EnglishName} {
+		if v != "" {
+			attrs = append(attrs, v)
+		}
+	}
+	return attrs
+}
+
+func (d *Directory) toUser(entry *ldap.Entry) *User {
+	a := d.conf.Attributes
+	get := func(name string) string {
+		if name == "" {
+			return ""
+		}
+		return entry.GetAttributeValue(name)
+	}
+	user := &User{
+		DN:          entry.DN,
+		Account:     get(a.Account),
+		Nickname:    get(a.Nickname),
+		Email:       get(a.Email),
+		Telephone:   get(a.Telephone),
+		Station:     get(a.Station),
+		EnglishName: get(a.EnglishName),
+	}
+	if strings.EqualFold(a.ID, "objectGUID") {
+		user.ID = hex.EncodeToString(entry.GetRawAttributeValue(a.ID))
+	} else {
+		user.ID = get(a.ID)
+	}
+	if user.ID == "" {
+		user.ID, _ = NormalizeDN(entry.DN)
+	}
+	if user.Nickname == "" {
+		user.Nickname = user.Account
+	}
+	return user
+}
+
+// secure reports whether the connection to the server is encrypted.
+func (d *Directory) secure() bool {
+	return d.conf.StartTLS || strings.HasPrefix(strings.ToLower(d.conf.URL), "ldaps://")
+}
+
+// Authenticate binds as the user with the account and returns the entry of the user.
+// The plaintext password is only sent over ldaps or StartTLS.
+func (d *Directory) Authenticate(account string, password string) (*User, error) {
+	if account == "" || password == "" {
+		return nil, eerrs.ErrPassword.Wrap()
+	}
+	if !d.secure() {
+		return nil, errs.ErrNoPermission.Wrap("ldap login requires ldaps or startTLS")
+	}
+	conn, err := d.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	filter := fmt.Sprintf("(&%s(%s=%s))", d.conf.UserFilter, d.conf.Attributes.Account, ldap.EscapeFilter(account))
+	res, err := conn.Search(ldap.NewSearchRequest(d.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, 0, false, filter, d.userAttributes(), nil))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(res.Entries) != 1 {
+		return nil, eerrs.ErrPassword.Wrap()
+	}
+	entry := res.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, eerrs.ErrPassword.Wrap()
+		}
+		return nil, errs.Wrap(err)
+	}
+	return d.toUser(entry), nil
+}
+
+// Load returns all departments, parents before children, and all users below the base dn.
+func (d *Directory) Load() ([]*Department, []*User, error) {
+	conn, err := d.dial()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+	res, err := conn.SearchWithPaging(ldap.NewSearchRequest(d.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false, d.conf.DepartmentFilter, []string{d.conf.Attributes.Department}, nil), 500)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	departments := make([]*Department, 0, len(res.Entries))
+	depth := make(map[string]int)
+	for _, entry := range res.Entries {
+		dn, err := ldap.ParseDN(entry.DN)
+		if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+			continue
+		}
+		name := entry.GetAttributeValue(d.conf.Attributes.Department)
+		if name == "" {
+			name = dn.RDNs[0].Attributes[0].Value
+		}
+		departments = append(departments, &Department{DN: entry.DN, Name: name})
+		depth[entry.DN] = len(dn.RDNs)
+	}
+	sort.SliceStable(departments, func(i, j int) bool {
+		return depth[departments[i].DN] < depth[departments[j].DN]
+	})
+	res, err = conn.SearchWithPaging(ldap.NewSearchRequest(d.conf.BaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false, d.conf.UserFilter, d.userAttributes(), nil), 500)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	users := make([]*User, 0, len(res.Entries))
+	for _, entry := range res.Entries {
+		user := d.toUser(entry)
+		if user.Account == "" {
+			continue
+		}
+		users = append(users, user)
+	}
+	return departments, users, nil
+}
+
+func joinRDNs(rdns []*ldap.RelativeDN) string {
+	parts := make([]string, 0, len(rdns))
+	for _, rdn := range rdns {
+		attrs := make([]string, 0, len(rdn.Attributes))
+		for _, attr := range rdn.Attributes {
+			attrs = append(attrs, strings.ToLower(attr.Type)+"="+ldap.EscapeDN(strings.ToLower(attr.Value)))
+		}
+		sort.Strings(attrs)
+		parts = append(parts, strings.Join(attrs, "+"))
+	}
+	return strings.Join(parts, ",")
+}
+
+// NormalizeDN returns the lower case form of the dn used to compare dns.
+func NormalizeDN(dn string) (string, error) {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return joinRDNs(parsed.RDNs), nil
+}
+
+// ParentDN returns the normalized dn of the parent entry.
+func ParentDN(dn string) (string, error) {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if len(parsed.RDNs) == 0 {
+		return "", nil
+	}
+	return joinRDNs(parsed.RDNs[1:]), nil
+}
+
+// DepartmentID returns the id of the department mirroring the organizational unit.
+func DepartmentID(dn string) (string, error) {
+	normalized, err := NormalizeDN(dn)
+	if err != nil {
+		return "", err
+	}
+	sum := sha1.Sum([]byte(normalized))
+	return departmentIDPrefix + hex.EncodeToString(sum[:]), nil
+}
+
+// IsDepartmentID reports whether the department is mirrored from the directory.
+func IsDepartmentID(departmentID string) bool {
+	return strings.HasPrefix(departmentID, departmentIDPrefix)
+}
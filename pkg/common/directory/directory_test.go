@@ -0,0 +1,241 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package directory
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+func TestDN(t *testing.T) {
+	parent, err := ParentDN("uid=Alice,OU=Dev,dc=example,dc=com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent != "ou=dev,dc=example,dc=com" {
+		t.Fatalf("parent %s", parent)
+	}
+	id1, err := DepartmentID("OU=Dev, DC=Example,DC=com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id2, _ := DepartmentID("ou=dev,dc=example,dc=com")
+	if id1 != id2 || !IsDepartmentID(id1) {
+		t.Fatalf("department id %s %s", id1, id2)
+	}
+	parent, _ = ParentDN(`cn=Smith\, John,ou=Sales\, East,dc=example,dc=com`)
+	if parent != `ou=sales\, east,dc=example,dc=com` {
+		t.Fatalf("escaped parent %s", parent)
+	}
+}
+
+// TestDirectory runs against a local server, e.g. docker run -p 389:389 osixia/openldap
+// LDAP_TEST_URL=ldap://127.0.0.1:389 LDAP_TEST_BIND_DN=cn=admin,dc=example,dc=org LDAP_TEST_BIND_PASSWORD=admin LDAP_TEST_BASE_DN=dc=example,dc=org
+// LDAP_TEST_ACCOUNT and LDAP_TEST_PASSWORD name a user below the base dn.
+func TestDirectory(t *testing.T) {
+	url := os.Getenv("LDAP_TEST_URL")
+	if url == "" {
+		t.Skip("LDAP_TEST_URL not set")
+	}
+	d := New(Config{
+		URL:          url,
+		BindDN:       os.Getenv("LDAP_TEST_BIND_DN"),
+		BindPassword: os.Getenv("LDAP_TEST_BIND_PASSWORD"),
+		BaseDN:       os.Getenv("LDAP_TEST_BASE_DN"),
+	})
+	departments, users, err := d.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("departments %d users %d", len(departments), len(users))
+	account := os.Getenv("LDAP_TEST_ACCOUNT")
+	if account == "" {
+		return
+	}
+	user, err := d.Authenticate(account, os.Getenv("LDAP_TEST_PASSWORD"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Account != account || user.ID == "" {
+		t.Fatalf("user %+v", user)
+	}
+	if _, err := d.Authenticate(account, "wrong password"); err == nil {
+		t.Fatal("wrong password accepted")
+	}
+}
+
+// mockServer is an ldaps server answering simple binds and searches for the given entries.
+type mockServer struct {
+	passwords map[string]string // dn -> password
+	entries   []*ldap.Entry
+}
+
+func selfSignedCert(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func (s *mockServer) listen(t *testing.T) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{selfSignedCert(t)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return "ldaps://" + ln.Addr().String()
+}
+
+func (s *mockServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		id := packet.Children[0].Value
+		op := packet.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn := op.Children[1].Data.String()
+			code := ldap.LDAPResultInvalidCredentials
+			if password, ok := s.passwords[dn]; ok && password == op.Children[2].Data.String() {
+				code = ldap.LDAPResultSuccess
+			}
+			s.reply(conn, id, ldap.ApplicationBindResponse, code)
+		case ldap.ApplicationSearchRequest:
+			filter, _ := ldap.DecompileFilter(op.Children[6])
+			for _, entry := range s.entries {
+				if !strings.Contains(filter, "(uid="+entry.GetAttributeValue("uid")+")") {
+					continue
+				}
+				res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "entry")
+				res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "dn"))
+				attrs := ber.NewSequence("attributes")
+				for _, attr := range entry.Attributes {
+					a := ber.NewSequence("attribute")
+					a.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, attr.Name, "type"))
+					vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+					for _, v := range attr.Values {
+						vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "val"))
+					}
+					a.AppendChild(vals)
+					attrs.AppendChild(a)
+				}
+				res.AppendChild(attrs)
+				s.write(conn, id, res)
+			}
+			s.reply(conn, id, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess)
+		case ldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func (s *mockServer) reply(conn net.Conn, id any, tag ber.Tag, code int) {
+	res := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "result")
+	res.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "code"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matched dn"))
+	res.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "message"))
+	s.write(conn, id, res)
+}
+
+func (s *mockServer) write(conn net.Conn, id any, op *ber.Packet) {
+	packet := ber.NewSequence("message")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "id"))
+	packet.AppendChild(op)
+	conn.Write(packet.Bytes())
+}
+
+func isPasswordErr(err error) bool {
+	codeErr, ok := errs.Unwrap(err).(errs.CodeError)
+	return ok && codeErr.Code() == eerrs.ErrPassword.Code()
+}
+
+func TestAuthenticate(t *testing.T) {
+	const aliceDN = "uid=alice,ou=dev,dc=example,dc=com"
+	server := &mockServer{
+		passwords: map[string]string{
+			"cn=admin,dc=example,dc=com": "admin",
+			aliceDN:                      "plain secret",
+		},
+		entries: []*ldap.Entry{ldap.NewEntry(aliceDN, map[string][]string{
+			"entryUUID": {"u-1"},
+			"uid":       {"alice"},
+			"cn":        {"Alice"},
+			"mail":      {"alice@example.com"},
+		})},
+	}
+	conf := Config{
+		URL:                server.listen(t),
+		InsecureSkipVerify: true,
+		BindDN:             "cn=admin,dc=example,dc=com",
+		BindPassword:       "admin",
+		BaseDN:             "dc=example,dc=com",
+	}
+	d := New(conf)
+	user, err := d.Authenticate("alice", "plain secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "u-1" || user.Account != "alice" || user.Email != "alice@example.com" {
+		t.Fatalf("user %+v", user)
+	}
+	if _, err := d.Authenticate("alice", "wrong password"); !isPasswordErr(err) {
+		t.Fatalf("wrong password: %v", err)
+	}
+	if _, err := d.Authenticate("bob", "plain secret"); !isPasswordErr(err) {
+		t.Fatalf("unknown account: %v", err)
+	}
+	conf.URL = strings.Replace(conf.URL, "ldaps://", "ldap://", 1)
+	if _, err := New(conf).Authenticate("alice", "plain secret"); err == nil {
+		t.Fatal("password sent without tls")
+	}
+}
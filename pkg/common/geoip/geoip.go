@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geoip resolves a coarse location of an ip from an offline csv database.
+//
+// Every line of the file is "start,end,country[,region[,city]]", start and end are the first and the last
+// address of a range, IPv4 or IPv6. Ranges must not overlap, lines starting with # and a header line are skipped.
+package geoip
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
+)
+
+type Location struct {
+	Country string
+	Region  string
+	City    string
+}
+
+// String joins the known parts of the location, it is empty when nothing is known.
+func (l Location) String() string {
+	parts := make([]string, 0, 3)
+	for _, s := range []string{l.Country, l.Region, l.City} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+type block struct {
+	start    netip.Addr
+	end      netip.Addr
+	location Location
+}
+
+// DB is read only once loaded, a nil DB knows no location.
+type DB struct {
+	blocks []block
+}
+
+func Open(path string) (*DB, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Parse(file)
+}
+
+func Parse(r io.Reader) (*DB, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var db DB
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(record) < 3 {
+			return nil, fmt.Errorf("geoip line %d: expect at least 3 fields", line)
+		}
+		start, err := iprule.ParseAddr(record[0])
+		if err != nil {
+			if line == 1 {
+				continue // header
+			}
+			return nil, fmt.Errorf("geoip line %d: %w", line, err)
+		}
+		end, err := iprule.ParseAddr(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("geoip line %d: %w", line, err)
+		}
+		if start.Is4() != end.Is4() || end.Less(start) {
+			return nil, fmt.Errorf("geoip line %d: invalid range %s-%s", line, start, end)
+		}
+		b := block{start: start, end: end, location: Location{Country: strings.TrimSpace(record[2])}}
+		if len(record) > 3 {
+			b.location.Region = strings.TrimSpace(record[3])
+		}
+		if len(record) > 4 {
+			b.location.City = strings.TrimSpace(record[4])
+		}
+		db.blocks = append(db.blocks, b)
+	}
+	sort.Slice(db.blocks, func(i, j int) bool { return db.blocks[i].start.Less(db.blocks[j].start) })
+	return &db, nil
+}
+
+// Lookup returns the location of the range the ip is in.
+func (d *DB) Lookup(ip string) (Location, bool) {
+	if d == nil {
+		return Location{}, false
+	}
+	addr, err := iprule.ParseAddr(ip)
+	if err != nil {
+		return Location{}, false
+	}
+	i := sort.Search(len(d.blocks), func(i int) bool { return addr.Less(d.blocks[i].start) }) - 1
+	if i < 0 || d.blocks[i].end.Less(addr) || d.blocks[i].start.Is4() != addr.Is4() {
+		return Location{}, false
+	}
+	return d.blocks[i].location, true
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geoip
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	db, err := Parse(strings.NewReader(`start,end,country,region,city
+# comment
+1.0.0.0,1.0.0.255,AU
+36.96.0.0,36.127.255.255,CN,Guangdong,Shenzhen
+2001:db8::,2001:db8:ffff:ffff:ffff:ffff:ffff:ffff,ZZ,Test
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ip, expect := range map[string]string{
+		"1.0.0.7":          "AU",
+		"36.100.1.1":       "CN Guangdong Shenzhen",
+		"::ffff:36.96.0.0": "CN Guangdong Shenzhen",
+		"2001:db8:1::1":    "ZZ Test",
+		"1.0.1.0":          "",
+		"8.8.8.8":          "",
+		"::1":              "",
+		"bad":              "",
+	} {
+		location, ok := db.Lookup(ip)
+		if ok != (expect != "") || location.String() != expect {
+			t.Errorf("%s: expect %q, got %q %v", ip, expect, location.String(), ok)
+		}
+	}
+	var empty *DB
+	if _, ok := empty.Lookup("1.0.0.1"); ok {
+		t.Error("nil db should not resolve")
+	}
+	if _, err := Parse(strings.NewReader("1.0.0.0,bad,AU\n")); err == nil {
+		t.Error("expect error")
+	}
+}
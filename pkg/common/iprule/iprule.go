@@ -0,0 +1,162 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iprule matches ip addresses against rules that are single addresses or CIDR blocks, IPv4 or IPv6.
+package iprule
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ParseRule parses an address or a CIDR block, an address is the block of its own length.
+func ParseRule(rule string) (netip.Prefix, error) {
+	rule = strings.TrimSpace(rule)
+	if strings.Contains(rule, "/") {
+		prefix, err := netip.ParsePrefix(rule)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid ip rule %q: %w", rule, err)
+		}
+		addr := prefix.Addr()
+		if addr.Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(rule)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid ip rule %q: %w", rule, err)
+	}
+	addr = addr.Unmap().WithZone("")
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// Normalize returns the canonical form of the rule to be saved, a single address is kept without the prefix length
+// so the rows saved before CIDR blocks were supported stay the same.
+func Normalize(rule string) (string, error) {
+	prefix, err := ParseRule(rule)
+	if err != nil {
+		return "", err
+	}
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String(), nil
+	}
+	return prefix.String(), nil
+}
+
+// ParseAddr parses the ip of a request, IPv4-mapped IPv6 addresses are matched as IPv4.
+func ParseAddr(ip string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap().WithZone(""), nil
+}
+
+// Network returns the block of the given length the ip belongs to, bits4 for IPv4 and bits6 for IPv6.
+func Network(ip string, bits4 int, bits6 int) (string, error) {
+	addr, err := ParseAddr(ip)
+	if err != nil {
+		return "", err
+	}
+	bits := bits6
+	if addr.Is4() {
+		bits = bits4
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return "", err
+	}
+	return prefix.String(), nil
+}
+
+// Match reports whether the ip is in the rule, invalid rules or ips never match.
+func Match(rule string, ip string) bool {
+	prefix, err := ParseRule(rule)
+	if err != nil {
+		return false
+	}
+	addr, err := ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(addr)
+}
+
+type node[V any] struct {
+	children [2]*node[V]
+	values   []V
+}
+
+// Trie is a binary prefix trie, looking up an ip walks at most 32 or 128 nodes whatever the number of rules.
+// It is not safe for concurrent writes, build it once and replace it to update.
+type Trie[V any] struct {
+	v4 node[V]
+	v6 node[V]
+}
+
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{}
+}
+
+func (t *Trie[V]) root(addr netip.Addr) *node[V] {
+	if addr.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+func bit(b []byte, i int) int {
+	return int(b[i/8]>>(7-i%8)) & 1
+}
+
+// Insert adds the value of the rule.
+func (t *Trie[V]) Insert(rule string, value V) error {
+	prefix, err := ParseRule(rule)
+	if err != nil {
+		return err
+	}
+	addr := prefix.Addr()
+	b := addr.AsSlice()
+	n := t.root(addr)
+	for i := 0; i < prefix.Bits(); i++ {
+		c := bit(b, i)
+		if n.children[c] == nil {
+			n.children[c] = &node[V]{}
+		}
+		n = n.children[c]
+	}
+	n.values = append(n.values, value)
+	return nil
+}
+
+// Lookup returns the values of every rule containing the ip, the widest block first.
+func (t *Trie[V]) Lookup(ip string) []V {
+	addr, err := ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	b := addr.AsSlice()
+	n := t.root(addr)
+	var values []V
+	for i := 0; n != nil; i++ {
+		values = append(values, n.values...)
+		if i == addr.BitLen() {
+			break
+		}
+		n = n.children[bit(b, i)]
+	}
+	return values
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iprule
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	for rule, expect := range map[string]string{
+		"192.168.1.7":         "192.168.1.7",
+		"192.168.1.7/24":      "192.168.1.0/24",
+		"192.168.1.7/32":      "192.168.1.7",
+		"::ffff:10.0.0.1":     "10.0.0.1",
+		"2001:DB8::1/48":      "2001:db8::/48",
+		"::ffff:10.0.0.0/104": "10.0.0.0/8",
+	} {
+		actual, err := Normalize(rule)
+		if err != nil {
+			t.Fatalf("%s: %v", rule, err)
+		}
+		if actual != expect {
+			t.Errorf("%s: expect %s, got %s", rule, expect, actual)
+		}
+	}
+	for _, rule := range []string{"", "1.2.3", "10.0.0.0/33", "2001:db8::/129"} {
+		if _, err := Normalize(rule); err == nil {
+			t.Errorf("%q: expect error", rule)
+		}
+	}
+}
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie[string]()
+	for _, rule := range []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.3", "2001:db8::/32", "0.0.0.0/0"} {
+		if err := trie.Insert(rule, rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for ip, expect := range map[string]int{
+		"10.1.2.3":        4,
+		"10.1.9.9":        3,
+		"10.9.9.9":        2,
+		"::ffff:10.9.9.9": 2,
+		"8.8.8.8":         1,
+		"2001:db8:1::1":   1,
+		"2001:db9::1":     0,
+		"not an ip":       0,
+	} {
+		if values := trie.Lookup(ip); len(values) != expect {
+			t.Errorf("%s: expect %d rules, got %v", ip, expect, values)
+		}
+	}
+	if !Match("10.0.0.0/8", "10.2.3.4") || Match("10.0.0.0/8", "11.0.0.1") || !Match("10.0.0.1", "10.0.0.1") {
+		t.Error("unexpected match")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loginlink signs the tokens of email magic links, the nonce inside is what makes a link single-use.
+package loginlink
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+// GenerateNonce returns a random nonce identifying one link.
+func GenerateNonce() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
+
+func sign(secret string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Sign returns the token of a link logging in userID until expire.
+func Sign(secret string, userID string, nonce string, expire time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{userID, nonce, strconv.FormatInt(expire.Unix(), 10)}, "\n")))
+	return payload + "." + sign(secret, payload)
+}
+
+// Parse checks the signature and the expiry of the token and returns its userID and nonce.
+func Parse(secret string, token string, now time.Time) (string, string, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(secret, payload))) {
+		return "", "", eerrs.ErrLoginLinkInvalid.Wrap("signature error")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", "", eerrs.ErrLoginLinkInvalid.Wrap("payload error")
+	}
+	fields := strings.Split(string(data), "\n")
+	if len(fields) != 3 {
+		return "", "", eerrs.ErrLoginLinkInvalid.Wrap("payload error")
+	}
+	expire, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return "", "", eerrs.ErrLoginLinkInvalid.Wrap("payload error")
+	}
+	if now.Unix() > expire {
+		return "", "", eerrs.ErrLoginLinkInvalid.Wrap("link expired")
+	}
+	return fields[0], fields[1], nil
+}
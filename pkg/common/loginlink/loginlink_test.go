@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loginlink
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignParse(t *testing.T) {
+	now := time.Now()
+	token := Sign("secret", "user1", "nonce1", now.Add(time.Minute))
+	userID, nonce, err := Parse("secret", token, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userID != "user1" || nonce != "nonce1" {
+		t.Fatalf("got %s %s", userID, nonce)
+	}
+	if _, _, err := Parse("other", token, now); err == nil {
+		t.Fatal("token signed with another secret accepted")
+	}
+	if _, _, err := Parse("secret", token, now.Add(time.Minute*2)); err == nil {
+		t.Fatal("expired token accepted")
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := Sign("secret", "user2", "nonce1", now.Add(time.Minute))
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	if _, _, err := Parse("secret", forgedPayload+"."+signature, now); err == nil {
+		t.Fatal("payload swapped under another signature accepted")
+	}
+	if _, _, err := Parse("secret", payload, now); err == nil {
+		t.Fatal("token without signature accepted")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loginlock locks accounts and ips after too many failed logins, shared by the chat and admin login.
+package loginlock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+// Store is implemented by the chat and the admin database.
+type Store interface {
+	GetLoginLock(ctx context.Context, key string) (time.Duration, error)
+	AddLoginFail(ctx context.Context, key string, policy *cache.LoginLockPolicy) (time.Duration, error)
+}
+
+// Policy returns the policy of the loginLock config with maxAttempts failures.
+func Policy(maxAttempts int64) *cache.LoginLockPolicy {
+	return &cache.LoginLockPolicy{
+		MaxAttempts: maxAttempts,
+		Window:      time.Duration(config.Config.LoginLock.Window) * time.Second,
+		LockTime:    time.Duration(config.Config.LoginLock.LockTime) * time.Second,
+		MaxLockTime: time.Duration(config.Config.LoginLock.MaxLockTime) * time.Second,
+	}
+}
+
+// Check fails if any of the keys is locked.
+func Check(ctx context.Context, store Store, keys ...string) error {
+	for _, key := range keys {
+		ttl, err := store.GetLoginLock(ctx, key)
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			return Locked(ttl + time.Second)
+		}
+	}
+	return nil
+}
+
+// AddFail counts a failure of the key and returns the lock time if the key got locked by it.
+func AddFail(ctx context.Context, store Store, key string, maxAttempts int64) (time.Duration, error) {
+	return store.AddLoginFail(ctx, key, Policy(maxAttempts))
+}
+
+// Locked returns the error of a key locked for lockTime.
+func Locked(lockTime time.Duration) error {
+	return eerrs.ErrAccountLocked.Wrap(fmt.Sprintf("retry after %d seconds", int(lockTime.Seconds())))
+}
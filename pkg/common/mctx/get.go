@@ -157,3 +157,12 @@ func WithAdminUser(ctx context.Context) context.Context {
 func WithApiToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, constant.CtxApiToken, token)
 }
+
+// GetLanguage returns the Accept-Language of the request forwarded by the api.
+func GetLanguage(ctx context.Context) string {
+	languages, _ := ctx.Value(constant.RpcOpLanguage).([]string)
+	if len(languages) == 0 {
+		return ""
+	}
+	return languages[0]
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/chat/pkg/common/audit"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+)
+
+const maxAuditErrorLen = 1024
+
+type AuditWriter interface {
+	AppendAuditLog(ctx context.Context, e *admin.AuditLog) error
+}
+
+// GrpcAudit appends the rpcs that admins call to change something to the audit log.
+// It must be chained after the interceptor that puts the metadata into the context.
+func GrpcAudit(writer AuditWriter) grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if !audit.ShouldAudit(info.FullMethod) {
+			return resp, err
+		}
+		opUserID, opUserType, checkErr := mctx.Check(ctx)
+		if checkErr != nil || opUserType != constant.AdminUser {
+			return resp, err
+		}
+		e := &admin.AuditLog{
+			Operator:   opUserID,
+			Action:     audit.Action(info.FullMethod),
+			CreateTime: time.Now().Truncate(time.Millisecond),
+		}
+		e.Detail, e.Target = audit.Detail(req)
+		if ips, _ := ctx.Value(constant.RpcOpIP).([]string); len(ips) > 0 {
+			e.IP = ips[0]
+		}
+		if err != nil {
+			e.Error = audit.Truncate(err.Error(), maxAuditErrorLen)
+		}
+		if err := writer.AppendAuditLog(ctx, e); err != nil {
+			log.ZError(ctx, "append audit log failed", err, "action", e.Action, "target", e.Target)
+		}
+		return resp, err
+	})
+}
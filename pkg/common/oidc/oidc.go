@@ -0,0 +1,282 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidc is a minimal OpenID Connect relying party using the authorization code flow with PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+type Config struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Claims are the claims of the id token mapped onto the chat user.
+type Claims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jwks struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+type Provider struct {
+	conf   Config
+	client *http.Client
+
+	lock      sync.Mutex
+	discovery *discovery
+	keys      map[string]*rsa.PublicKey
+	keysTime  time.Time
+}
+
+// NewProvider creates the provider, the discovery document is fetched on first use so an unreachable idp does not block startup.
+func NewProvider(conf Config) *Provider {
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = []string{"openid", "email", "profile"}
+	}
+	conf.Issuer = strings.TrimSuffix(conf.Issuer, "/")
+	return &Provider{conf: conf, client: &http.Client{Timeout: time.Second * 10}}
+}
+
+func (p *Provider) Name() string {
+	return p.conf.Name
+}
+
+// RandomString returns a url safe random string used as state, nonce and PKCE verifier.
+func RandomString() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// CodeChallenge returns the S256 challenge of the PKCE verifier.
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (p *Provider) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errs.Wrap(fmt.Errorf("get %s status %d", u, resp.StatusCode))
+	}
+	return errs.Wrap(json.NewDecoder(resp.Body).Decode(v))
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discovery, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	var d discovery
+	if err := p.getJSON(ctx, p.conf.Issuer+"/.well-known/openid-configuration", &d); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(d.Issuer, "/") != p.conf.Issuer {
+		return nil, errs.Wrap(fmt.Errorf("issuer mismatch %s", d.Issuer))
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+// getKey returns the signing key of kid, the key set is refetched at most once a minute for unknown kids.
+func (p *Provider) getKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(p.keysTime) < time.Minute {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("unknown kid " + kid)
+	}
+	var set jwks
+	if err := p.getJSON(ctx, d.JwksURI, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	p.keys = keys
+	p.keysTime = time.Now()
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("unknown kid " + kid)
+	}
+	return key, nil
+}
+
+// AuthURL returns the authorization endpoint url the user is redirected to.
+func (p *Provider) AuthURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.conf.ClientID},
+		"redirect_uri":          {p.conf.RedirectURL},
+		"scope":                 {strings.Join(p.conf.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(d.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return d.AuthorizationEndpoint + sep + query.Encode(), nil
+}
+
+// Exchange redeems the authorization code and returns the verified claims of the id token.
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string, nonce string) (*Claims, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectURL},
+		"client_id":     {p.conf.ClientID},
+		"client_secret": {p.conf.ClientSecret},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, eerrs.ErrIdentityInvalid.Wrap(fmt.Sprintf("token endpoint status %d %s", resp.StatusCode, body))
+	}
+	var token struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if token.IDToken == "" {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("id token is empty")
+	}
+	return p.Verify(ctx, token.IDToken, nonce)
+}
+
+// Verify checks the signature, issuer, audience, expiry and nonce of the id token.
+func (p *Provider) Verify(ctx context.Context, idToken string, nonce string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(idToken, &claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, eerrs.ErrIdentityInvalid.Wrap(err.Error())
+	}
+	if !claims.VerifyIssuer(p.conf.Issuer, true) {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("issuer mismatch")
+	}
+	if !claims.VerifyAudience(p.conf.ClientID, true) {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("audience mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("subject is empty")
+	}
+	if claims.Nonce != nonce {
+		return nil, eerrs.ErrIdentityInvalid.Wrap("nonce mismatch")
+	}
+	return &claims, nil
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// mockIdP is a local identity provider issuing one code per authorization request.
+type mockIdP struct {
+	t         *testing.T
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	challenge string
+	nonce     string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockIdP{t: t, key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.server.URL,
+			"authorization_endpoint": m.server.URL + "/authorize",
+			"token_endpoint":         m.server.URL + "/token",
+			"jwks_uri":               m.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kid": "k1",
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code1" || CodeChallenge(r.FormValue("code_verifier")) != m.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": m.sign(m.nonce)})
+	})
+	m.server = httptest.NewServer(mux)
+	return m
+}
+
+func (m *mockIdP) sign(nonce string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.server.URL,
+			Subject:   "sub1",
+			Audience:  jwt.ClaimStrings{"client1"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+		Nonce:         nonce,
+		Email:         "user1@example.com",
+		EmailVerified: true,
+	})
+	token.Header["kid"] = "k1"
+	s, err := token.SignedString(m.key)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return s
+}
+
+func TestProvider(t *testing.T) {
+	idp := newMockIdP(t)
+	defer idp.server.Close()
+	ctx := context.Background()
+	p := NewProvider(Config{Name: "mock", Issuer: idp.server.URL, ClientID: "client1", RedirectURL: "http://localhost/callback"})
+
+	verifier, _ := RandomString()
+	authURL, err := p.AuthURL(ctx, "state1", "nonce1", verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := u.Query()
+	if query.Get("state") != "state1" || query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("auth url %s", authURL)
+	}
+	idp.challenge = query.Get("code_challenge")
+	idp.nonce = query.Get("nonce")
+
+	claims, err := p.Exchange(ctx, "code1", verifier, "nonce1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "sub1" || claims.Email != "user1@example.com" || !claims.EmailVerified {
+		t.Fatalf("claims %+v", claims)
+	}
+	if _, err := p.Exchange(ctx, "code1", "wrong verifier", "nonce1"); err == nil {
+		t.Fatal("wrong verifier accepted")
+	}
+	if _, err := p.Verify(ctx, idp.sign("nonce1"), "nonce2"); err == nil {
+		t.Fatal("nonce mismatch accepted")
+	}
+	other := NewProvider(Config{Issuer: idp.server.URL, ClientID: "client2"})
+	if _, err := other.Verify(ctx, idp.sign("nonce1"), "nonce1"); err == nil {
+		t.Fatal("token of another client accepted")
+	}
+}
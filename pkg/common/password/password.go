@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package password hashes the password sent by the client before it is stored.
+// The client sends the md5 of the plain password, older rows stored that md5 as is.
+package password
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const cost = bcrypt.DefaultCost
+
+// Hash returns the salted bcrypt hash of password.
+func Hash(password string) (string, error) {
+	if password == "" {
+		return "", errs.ErrArgs.Wrap("password is empty")
+	}
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return string(hashed), nil
+}
+
+// IsHashed reports whether stored was produced by Hash.
+func IsHashed(stored string) bool {
+	return strings.HasPrefix(stored, "$2a$") || strings.HasPrefix(stored, "$2b$") || strings.HasPrefix(stored, "$2y$")
+}
+
+// Verify compares password with the stored value in constant time.
+// upgrade is true when the password matched a legacy value that should be replaced by Hash.
+func Verify(stored string, password string) (match bool, upgrade bool) {
+	if stored == "" || password == "" {
+		return false, false
+	}
+	if IsHashed(stored) {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, false
+	}
+	match = subtle.ConstantTimeCompare([]byte(stored), []byte(password)) == 1
+	return match, match
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package password
+
+import "testing"
+
+func TestVerify(t *testing.T) {
+	const md5 = "e10adc3949ba59abbe56e057f20f883e"
+	hashed, err := Hash(md5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsHashed(hashed) {
+		t.Fatalf("unexpected hash format %s", hashed)
+	}
+	if match, upgrade := Verify(hashed, md5); !match || upgrade {
+		t.Fatalf("hashed: match=%v upgrade=%v", match, upgrade)
+	}
+	if match, _ := Verify(hashed, md5[1:]); match {
+		t.Fatal("wrong password matched")
+	}
+	if match, upgrade := Verify(md5, md5); !match || !upgrade {
+		t.Fatalf("legacy: match=%v upgrade=%v", match, upgrade)
+	}
+	if match, _ := Verify("", ""); match {
+		t.Fatal("empty password matched")
+	}
+}
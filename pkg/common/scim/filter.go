@@ -0,0 +1,351 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scim holds the SCIM 2.0 resources together with the filter and patch semantics of RFC 7644.
+package scim
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter matches the json object of a resource.
+type Filter interface {
+	Match(resource map[string]any) bool
+}
+
+type andFilter struct{ left, right Filter }
+
+func (f andFilter) Match(r map[string]any) bool { return f.left.Match(r) && f.right.Match(r) }
+
+type orFilter struct{ left, right Filter }
+
+func (f orFilter) Match(r map[string]any) bool { return f.left.Match(r) || f.right.Match(r) }
+
+type notFilter struct{ filter Filter }
+
+func (f notFilter) Match(r map[string]any) bool { return !f.filter.Match(r) }
+
+// CompareFilter is an attribute expression such as userName eq "alice".
+type CompareFilter struct {
+	Path  string
+	Op    string
+	Value any
+}
+
+func (f CompareFilter) Match(r map[string]any) bool {
+	values := Values(r, f.Path)
+	if f.Op == "pr" {
+		for _, v := range values {
+			if v != nil && v != "" {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range values {
+		if compare(v, f.Op, f.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func compare(v any, op string, want any) bool {
+	if op == "eq" || op == "ne" {
+		eq := strings.EqualFold(toString(v), toString(want))
+		return eq == (op == "eq")
+	}
+	s, w := strings.ToLower(toString(v)), strings.ToLower(toString(want))
+	switch op {
+	case "co":
+		return strings.Contains(s, w)
+	case "sw":
+		return strings.HasPrefix(s, w)
+	case "ew":
+		return strings.HasSuffix(s, w)
+	}
+	a, errA := strconv.ParseFloat(s, 64)
+	b, errB := strconv.ParseFloat(w, 64)
+	if errA != nil || errB != nil {
+		a, b = 0, float64(strings.Compare(s, w))
+	}
+	switch op {
+	case "gt":
+		return a > b
+	case "ge":
+		return a >= b
+	case "lt":
+		return a < b
+	case "le":
+		return a <= b
+	}
+	return false
+}
+
+func toString(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// Values returns the values at the attribute path, multi-valued attributes are flattened.
+func Values(r map[string]any, path string) []any {
+	// schema qualified paths such as urn:ietf:params:scim:schemas:core:2.0:User:userName
+	if i := strings.LastIndex(path, ":"); i >= 0 {
+		path = path[i+1:]
+	}
+	name, sub, _ := strings.Cut(path, ".")
+	v, ok := lookup(r, name)
+	if !ok {
+		return nil
+	}
+	var items []any
+	if arr, ok := v.([]any); ok {
+		items = arr
+	} else {
+		items = []any{v}
+	}
+	if sub == "" {
+		res := make([]any, 0, len(items))
+		for _, item := range items {
+			// a complex multi-valued attribute compares by its value sub-attribute
+			if m, ok := item.(map[string]any); ok {
+				item, _ = lookup(m, "value")
+			}
+			res = append(res, item)
+		}
+		return res
+	}
+	var res []any
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			res = append(res, Values(m, sub)...)
+		}
+	}
+	return res
+}
+
+func lookup(m map[string]any, name string) (any, bool) {
+	if v, ok := m[name]; ok {
+		return v, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// ParseFilter parses the filter query parameter.
+func ParseFilter(s string) (Filter, error) {
+	p := &parser{tokens: tokenize(s)}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	return f, nil
+}
+
+func tokenize(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ':
+			i++
+		case c == '(' || c == ')' || c == '[' || c == ']':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '"':
+			j := i + 1
+			for j < len(s) && s[j] != '"' {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				j = len(s) - 1
+			}
+			tokens = append(tokens, s[i:j+1])
+			i = j + 1
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" ()[]\"", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Filter, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for strings.EqualFold(p.peek(), "and") {
+		p.next()
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseFactor() (Filter, error) {
+	t := p.next()
+	switch {
+	case t == "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	case strings.EqualFold(t, "not"):
+		f, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{f}, nil
+	case t == "(":
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		return f, nil
+	}
+	path := t
+	if p.peek() == "[" {
+		// emails[type eq "work"] is matched as emails.type eq "work"
+		p.next()
+		sub, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != "]" {
+			return nil, fmt.Errorf("missing ]")
+		}
+		return valueFilter{path: path, filter: sub}, nil
+	}
+	op := strings.ToLower(p.next())
+	switch op {
+	case "pr":
+		return CompareFilter{Path: path, Op: op}, nil
+	case "eq", "ne", "co", "sw", "ew", "gt", "ge", "lt", "le":
+	default:
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+	raw := p.next()
+	if raw == "" {
+		return nil, fmt.Errorf("missing value of %s", path)
+	}
+	return CompareFilter{Path: path, Op: op, Value: parseValue(raw)}, nil
+}
+
+func parseValue(raw string) any {
+	if strings.HasPrefix(raw, `"`) {
+		s, err := strconv.Unquote(raw)
+		if err != nil {
+			return strings.Trim(raw, `"`)
+		}
+		return s
+	}
+	switch strings.ToLower(raw) {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f
+	}
+	return raw
+}
+
+// valueFilter matches a multi-valued attribute having an element matching the filter.
+type valueFilter struct {
+	path   string
+	filter Filter
+}
+
+func (f valueFilter) Match(r map[string]any) bool {
+	v, ok := lookup(r, f.path)
+	if !ok {
+		return false
+	}
+	arr, ok := v.([]any)
+	if !ok {
+		arr = []any{v}
+	}
+	for _, item := range arr {
+		if m, ok := item.(map[string]any); ok && f.filter.Match(m) {
+			return true
+		}
+	}
+	return false
+}
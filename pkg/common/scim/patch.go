@@ -0,0 +1,201 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+type PatchOp struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// patchPath is attr[filter].sub where the filter and the sub attribute are optional.
+type patchPath struct {
+	attr   string
+	filter Filter
+	sub    string
+}
+
+func parsePatchPath(path string) (*patchPath, error) {
+	if i := strings.Index(path, "["); i >= 0 {
+		j := strings.LastIndex(path, "]")
+		if j < i {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+		filter, err := ParseFilter(path[i+1 : j])
+		if err != nil {
+			return nil, err
+		}
+		return &patchPath{attr: path[:i], filter: filter, sub: strings.TrimPrefix(path[j+1:], ".")}, nil
+	}
+	// schema qualified paths, the attribute is after the last colon
+	if i := strings.LastIndex(path, ":"); i >= 0 {
+		path = path[i+1:]
+	}
+	attr, sub, _ := strings.Cut(path, ".")
+	return &patchPath{attr: attr, sub: sub}, nil
+}
+
+// key returns the key of name in m, keeping the spelling already used by the resource.
+func key(m map[string]any, name string) string {
+	for k := range m {
+		if strings.EqualFold(k, name) {
+			return k
+		}
+	}
+	return name
+}
+
+// ApplyPatch applies the operations to the json object of a resource.
+func ApplyPatch(resource map[string]any, ops []PatchOperation) error {
+	for _, op := range ops {
+		name := strings.ToLower(op.Op)
+		if op.Path == "" {
+			if name == "remove" {
+				return fmt.Errorf("remove requires a path")
+			}
+			values, ok := op.Value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s without path requires an object value", op.Op)
+			}
+			for k, v := range values {
+				if err := applyPath(resource, name, k, v); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if err := applyPath(resource, name, op.Path, op.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPath(resource map[string]any, op string, path string, value any) error {
+	p, err := parsePatchPath(path)
+	if err != nil {
+		return err
+	}
+	k := key(resource, p.attr)
+	if p.filter == nil {
+		if p.sub != "" {
+			m, _ := resource[k].(map[string]any)
+			if m == nil {
+				if op == "remove" {
+					return nil
+				}
+				m = make(map[string]any)
+				resource[k] = m
+			}
+			return applyPath(m, op, p.sub, value)
+		}
+		switch op {
+		case "remove":
+			// some providers remove members by listing them in the value instead of a filter
+			arr, ok := resource[k].([]any)
+			remove, _ := value.([]any)
+			if !ok || len(remove) == 0 {
+				delete(resource, k)
+				return nil
+			}
+			res := make([]any, 0, len(arr))
+			for _, item := range arr {
+				if !containsValue(remove, item) {
+					res = append(res, item)
+				}
+			}
+			resource[k] = res
+		case "add":
+			if arr, ok := resource[k].([]any); ok {
+				if add, ok := value.([]any); ok {
+					resource[k] = append(arr, add...)
+					return nil
+				}
+			}
+			resource[k] = value
+		case "replace":
+			resource[k] = value
+		default:
+			return fmt.Errorf("unknown op %q", op)
+		}
+		return nil
+	}
+	arr, _ := resource[k].([]any)
+	res := make([]any, 0, len(arr))
+	var matched bool
+	for _, item := range arr {
+		m, ok := item.(map[string]any)
+		if !ok || !p.filter.Match(m) {
+			res = append(res, item)
+			continue
+		}
+		matched = true
+		switch {
+		case op == "remove" && p.sub == "":
+			continue
+		case op == "remove":
+			delete(m, key(m, p.sub))
+		case p.sub == "":
+			if v, ok := value.(map[string]any); ok {
+				for vk, vv := range v {
+					m[key(m, vk)] = vv
+				}
+			}
+		default:
+			m[key(m, p.sub)] = value
+		}
+		res = append(res, m)
+	}
+	if !matched && op != "remove" {
+		// add to a filtered path creates the element, e.g. emails[type eq "work"].value
+		if cmp, ok := p.filter.(CompareFilter); ok && cmp.Op == "eq" {
+			m := map[string]any{cmp.Path: cmp.Value}
+			if p.sub != "" {
+				m[p.sub] = value
+			} else if v, ok := value.(map[string]any); ok {
+				for vk, vv := range v {
+					m[vk] = vv
+				}
+			}
+			res = append(res, m)
+		}
+	}
+	resource[k] = res
+	return nil
+}
+
+// containsValue reports whether the multi-valued item has the value of one of the values.
+func containsValue(values []any, item any) bool {
+	m, ok := item.(map[string]any)
+	if !ok {
+		return false
+	}
+	for _, v := range values {
+		if vm, ok := v.(map[string]any); ok && toString(vm["value"]) == toString(m["value"]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testUser(t *testing.T) map[string]any {
+	var m map[string]any
+	data := `{"userName":"alice","active":true,"title":"Dev","emails":[{"value":"alice@example.com","type":"work","primary":true}],"name":{"formatted":"Alice"}}`
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestFilter(t *testing.T) {
+	user := testUser(t)
+	cases := map[string]bool{
+		`userName eq "Alice"`:                                            true,
+		`userName eq "bob"`:                                              false,
+		`emails.value eq "alice@example.com"`:                            true,
+		`emails[type eq "work" and value co "alice"]`:                    true,
+		`emails[type eq "home"]`:                                         false,
+		`userName sw "al" and (title eq "x" or active eq true)`:          true,
+		`not (userName eq "alice")`:                                      false,
+		`name.formatted pr`:                                              true,
+		`nickName pr`:                                                    false,
+		`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "alice"`: true,
+	}
+	for filter, want := range cases {
+		f, err := ParseFilter(filter)
+		if err != nil {
+			t.Fatalf("%s: %v", filter, err)
+		}
+		if got := f.Match(user); got != want {
+			t.Errorf("%s: got %v", filter, got)
+		}
+	}
+	if _, err := ParseFilter(`userName xx "a"`); err == nil {
+		t.Error("unknown operator accepted")
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	user := testUser(t)
+	err := ApplyPatch(user, []PatchOperation{
+		{Op: "Replace", Path: "active", Value: "False"},
+		{Op: "replace", Path: `emails[type eq "work"].value`, Value: "a@example.com"},
+		{Op: "add", Path: `phoneNumbers[type eq "work"].value`, Value: "123"},
+		{Op: "replace", Value: map[string]any{"title": "Ops", "name.formatted": "Alice A"}},
+		{Op: "remove", Path: "userName"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u User
+	if err := FromMap(user, &u); err != nil {
+		t.Fatal(err)
+	}
+	if u.Active || u.Title != "Ops" || u.UserName != "" || u.Name.Formatted != "Alice A" {
+		t.Fatalf("user %+v", u)
+	}
+	if PrimaryValue(u.Emails) != "a@example.com" || PrimaryValue(u.PhoneNumbers) != "123" {
+		t.Fatalf("multi values %+v %+v", u.Emails, u.PhoneNumbers)
+	}
+	group := map[string]any{"displayName": "Dev", "members": []any{map[string]any{"value": "u1"}, map[string]any{"value": "u2"}}}
+	err = ApplyPatch(group, []PatchOperation{
+		{Op: "add", Path: "members", Value: []any{map[string]any{"value": "u3"}}},
+		{Op: "remove", Path: `members[value eq "u1"]`},
+		{Op: "add", Path: "members", Value: []any{map[string]any{"value": "u4"}}},
+		{Op: "remove", Path: "members", Value: []any{map[string]any{"value": "u4"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var g Group
+	if err := FromMap(group, &g); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Members) != 2 || g.Members[0].Value != "u2" || g.Members[1].Value != "u3" {
+		t.Fatalf("members %+v", g.Members)
+	}
+}
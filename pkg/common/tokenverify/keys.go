@@ -0,0 +1,233 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+const rsaKeyBits = 2048
+
+// Key is an asymmetric signing key identified by the kid header of the tokens it signs.
+type Key struct {
+	KID        string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreateTime time.Time
+}
+
+func (k *Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+type keySet struct {
+	signing *Key
+	verify  map[string]*Key
+}
+
+var keys atomic.Pointer[keySet]
+
+// SetKeys replaces the keys, tokens are signed by signing and verified by any of verify.
+// A nil signing key falls back to HS256 with the shared secret.
+func SetKeys(signing *Key, verify []*Key) {
+	set := &keySet{signing: signing, verify: make(map[string]*Key)}
+	for _, key := range verify {
+		set.verify[key.KID] = key
+	}
+	if signing != nil {
+		set.verify[signing.KID] = signing
+	}
+	keys.Store(set)
+}
+
+func loadKeys() *keySet {
+	if set := keys.Load(); set != nil {
+		return set
+	}
+	return &keySet{}
+}
+
+func CheckAlgorithm(algorithm string) error {
+	switch algorithm {
+	case AlgRS256, AlgEdDSA:
+		return nil
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
+
+// GenerateKey creates a key with a random kid.
+func GenerateKey(algorithm string) (*Key, error) {
+	if err := CheckAlgorithm(algorithm); err != nil {
+		return nil, err
+	}
+	kid := make([]byte, 16)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, err
+	}
+	key := &Key{KID: hex.EncodeToString(kid), Algorithm: algorithm, CreateTime: time.Now()}
+	var err error
+	if algorithm == AlgRS256 {
+		key.PrivateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	} else {
+		_, key.PrivateKey, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// MarshalPrivateKey encodes the private key as a PKCS #8 PEM block.
+func MarshalPrivateKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// encryptedKeyPrefix marks private keys sealed by EncryptPrivateKey, other rows are plain PEM.
+const encryptedKeyPrefix = "aes-gcm:"
+
+func keyCipher(secret string) (cipher.AEAD, error) {
+	sum := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptPrivateKey seals the PEM private key with AES-GCM under a key derived from secret.
+func EncryptPrivateKey(data string, secret string) (string, error) {
+	aead, err := keyCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(data), nil)
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptPrivateKey opens a key sealed by EncryptPrivateKey, plain PEM keys are returned as they are.
+func DecryptPrivateKey(data string, secret string) (string, error) {
+	if !strings.HasPrefix(data, encryptedKeyPrefix) {
+		return data, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(data, encryptedKeyPrefix))
+	if err != nil {
+		return "", err
+	}
+	aead, err := keyCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted private key")
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt private key: %w", err)
+	}
+	return string(plain), nil
+}
+
+func ParsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, fmt.Errorf("invalid pem private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %T", key)
+	}
+	return signer, nil
+}
+
+// JWK is the public part of a key as published in the jwks.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+func (k *Key) JWK() *JWK {
+	jwk := &JWK{Kid: k.KID, Alg: k.Algorithm, Use: "sig"}
+	switch pub := k.PrivateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// JWKS returns the public keys of all keys tokens are verified with.
+func JWKS() []*JWK {
+	set := loadKeys()
+	verify := make([]*Key, 0, len(set.verify))
+	for _, key := range set.verify {
+		verify = append(verify, key)
+	}
+	sort.Slice(verify, func(i, j int) bool {
+		return verify[i].CreateTime.After(verify[j].CreateTime)
+	})
+	res := make([]*JWK, 0, len(verify))
+	for _, key := range verify {
+		res = append(res, key.JWK())
+	}
+	return res
+}
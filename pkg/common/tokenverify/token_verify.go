@@ -32,99 +32,161 @@ type claims struct {
 	UserID     string
 	UserType   int32
 	PlatformID int32
+	Scope      string `json:",omitempty"`
 	jwt.RegisteredClaims
 }
 
-func buildClaims(userID string, userType int32, ttl int64) claims {
+func buildClaims(userID string, userType int32, platformID int32, scope string, ttl time.Duration) claims {
 	now := time.Now()
 	before := now.Add(-time.Minute * 5)
 	return claims{
-		UserID:   userID,
-		UserType: userType,
+		UserID:     userID,
+		UserType:   userType,
+		PlatformID: platformID,
+		Scope:      scope,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(ttl*24) * time.Hour)), // Expiration time
-			IssuedAt:  jwt.NewNumericDate(now),                                        // Issuing time
-			NotBefore: jwt.NewNumericDate(before),                                     // Begin Effective time
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)), // Expiration time
+			IssuedAt:  jwt.NewNumericDate(now),          // Issuing time
+			NotBefore: jwt.NewNumericDate(before),       // Begin Effective time
 		},
 	}
 }
 
-func CreateToken(UserID string, userType int32, ttl int64) (string, error) {
+func CreateToken(UserID string, userType int32, platformID int32, ttl time.Duration) (string, error) {
+	return CreateScopeToken(UserID, userType, platformID, "", ttl)
+}
+
+// CreateScopeToken creates a token that can only be used for scope, an empty scope is not limited.
+func CreateScopeToken(UserID string, userType int32, platformID int32, scope string, ttl time.Duration) (string, error) {
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", errs.ErrTokenUnknown.Wrap("token type unknown")
 	}
-	claims := buildClaims(UserID, userType, ttl)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(*config.Config.Secret))
+	claims := buildClaims(UserID, userType, platformID, scope, ttl)
+	var (
+		tokenString string
+		err         error
+	)
+	if key := loadKeys().signing; key != nil {
+		token := jwt.NewWithClaims(key.method(), claims)
+		token.Header["kid"] = key.KID
+		tokenString, err = token.SignedString(key.PrivateKey)
+	} else {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenString, err = token.SignedString([]byte(*config.Config.Secret))
+	}
 	if err != nil {
 		return "", errs.Wrap(err, "")
 	}
 	return tokenString, nil
 }
 
+// acceptSecret reports whether tokens signed by the shared secret still verify.
+// Once RS256 or EdDSA signs, they only do until acceptSecretUntil.
+func acceptSecret() bool {
+	if loadKeys().signing == nil {
+		return true
+	}
+	until, err := time.Parse(time.RFC3339, config.Config.TokenPolicy.SigningKey.AcceptSecretUntil)
+	return err == nil && time.Now().Before(until)
+}
+
+// secret picks the key by the kid header, tokens without kid are signed by the shared secret.
 func secret() jwt.Keyfunc {
 	return func(token *jwt.Token) (interface{}, error) {
-		return []byte(*config.Config.Secret), nil
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errs.ErrTokenUnknown.Wrap("unexpected signing method")
+			}
+			if !acceptSecret() {
+				return nil, errs.ErrTokenUnknown.Wrap("tokens signed by the shared secret are no longer accepted")
+			}
+			return []byte(*config.Config.Secret), nil
+		}
+		key, ok := loadKeys().verify[kid]
+		if !ok {
+			return nil, errs.ErrTokenUnknown.Wrap("unknown kid")
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, errs.ErrTokenUnknown.Wrap("unexpected signing method")
+		}
+		return key.PrivateKey.Public(), nil
 	}
 }
 
-func getToken(t string) (string, int32, error) {
+func getToken(t string) (*claims, error) {
 	token, err := jwt.ParseWithClaims(t, &claims{}, secret())
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
 			if ve.Errors&jwt.ValidationErrorMalformed != 0 {
-				return "", 0, errs.ErrTokenMalformed.Wrap()
+				return nil, errs.ErrTokenMalformed.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorExpired != 0 {
-				return "", 0, errs.ErrTokenExpired.Wrap()
+				return nil, errs.ErrTokenExpired.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorNotValidYet != 0 {
-				return "", 0, errs.ErrTokenNotValidYet.Wrap()
+				return nil, errs.ErrTokenNotValidYet.Wrap()
 			} else {
-				return "", 0, errs.ErrTokenUnknown.Wrap()
+				return nil, errs.ErrTokenUnknown.Wrap()
 			}
 		} else {
-			return "", 0, errs.ErrTokenNotValidYet.Wrap()
+			return nil, errs.ErrTokenNotValidYet.Wrap()
 		}
 	} else {
+		// im tokens signed with the same secret carry no user type, they are rejected by the type checks.
 		claims, ok := token.Claims.(*claims)
-		if claims.PlatformID != 0 {
-			return "", 0, errs.ErrTokenNotExist.Wrap()
-		}
 		if ok && token.Valid {
-			return claims.UserID, claims.UserType, nil
+			return claims, nil
 		}
-		return "", 0, errs.ErrTokenNotValidYet.Wrap()
+		return nil, errs.ErrTokenNotValidYet.Wrap()
 	}
 }
 
 func GetToken(token string) (string, int32, error) {
-	userID, userType, err := getToken(token)
+	claims, err := getToken(token)
 	if err != nil {
 		return "", 0, err
 	}
-	if !(userType == TokenUser || userType == TokenAdmin) {
+	if !(claims.UserType == TokenUser || claims.UserType == TokenAdmin) {
 		return "", 0, errs.ErrTokenUnknown.Wrap("token type unknown")
 	}
-	return userID, userType, nil
+	return claims.UserID, claims.UserType, nil
+}
+
+// GetTokenPlatform returns the platform the token was issued for, 0 for tokens issued before platforms were recorded.
+func GetTokenPlatform(token string) (int32, error) {
+	claims, err := getToken(token)
+	if err != nil {
+		return 0, err
+	}
+	return claims.PlatformID, nil
+}
+
+// GetTokenScope returns the scope the token is limited to, empty for a full token.
+func GetTokenScope(token string) (string, error) {
+	claims, err := getToken(token)
+	if err != nil {
+		return "", err
+	}
+	return claims.Scope, nil
 }
 
 func GetAdminToken(token string) (string, error) {
-	userID, userType, err := getToken(token)
+	claims, err := getToken(token)
 	if err != nil {
 		return "", err
 	}
-	if userType != TokenAdmin {
+	if claims.UserType != TokenAdmin {
 		return "", errs.ErrTokenInvalid.Wrap("token type error")
 	}
-	return userID, nil
+	return claims.UserID, nil
 }
 
 func GetUserToken(token string) (string, error) {
-	userID, userType, err := getToken(token)
+	claims, err := getToken(token)
 	if err != nil {
 		return "", err
 	}
-	if userType != TokenUser {
+	if claims.UserType != TokenUser {
 		return "", errs.ErrTokenInvalid.Wrap("token type error")
 	}
-	return userID, nil
+	return claims.UserID, nil
 }
@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func TestSigningKeys(t *testing.T) {
+	secret := "test-secret"
+	config.Config.Secret = &secret
+	config.Config.TokenPolicy.SigningKey.AcceptSecretUntil = time.Now().Add(time.Hour).Format(time.RFC3339)
+	defer func() {
+		SetKeys(nil, nil)
+		config.Config.TokenPolicy.SigningKey.AcceptSecretUntil = ""
+	}()
+
+	legacy, err := CreateToken("u1", TokenUser, 1, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, alg := range []string{AlgRS256, AlgEdDSA} {
+		old, err := GenerateKey(alg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetKeys(old, nil)
+		oldToken, err := CreateToken("u1", TokenUser, 1, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := MarshalPrivateKey(old.PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sealed, err := EncryptPrivateKey(data, "encryption key")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := DecryptPrivateKey(sealed, "wrong key"); err == nil {
+			t.Fatalf("%s: private key decrypted with a wrong key", alg)
+		}
+		if data, err = DecryptPrivateKey(sealed, "encryption key"); err != nil {
+			t.Fatal(err)
+		}
+		if old.PrivateKey, err = ParsePrivateKey(data); err != nil {
+			t.Fatal(err)
+		}
+		cur, err := GenerateKey(alg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetKeys(cur, []*Key{old})
+		token, err := CreateToken("u2", TokenAdmin, 2, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if userID, userType, err := GetToken(token); err != nil || userID != "u2" || userType != TokenAdmin {
+			t.Fatalf("%s: %s %d %v", alg, userID, userType, err)
+		}
+		for _, tk := range []string{oldToken, legacy} {
+			if userID, err := GetUserToken(tk); err != nil || userID != "u1" {
+				t.Fatalf("%s: %s %v", alg, userID, err)
+			}
+		}
+		if len(JWKS()) != 2 || JWKS()[0].Kid != cur.KID {
+			t.Fatalf("%s: jwks %+v", alg, JWKS())
+		}
+		scoped, err := CreateScopeToken("u1", TokenUser, 1, "password", time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if scope, err := GetTokenScope(scoped); err != nil || scope != "password" {
+			t.Fatalf("%s: scope %q %v", alg, scope, err)
+		}
+		if scope, err := GetTokenScope(token); err != nil || scope != "" {
+			t.Fatalf("%s: scope %q %v", alg, scope, err)
+		}
+		// the old key is past its grace period
+		SetKeys(cur, nil)
+		if _, _, err := GetToken(oldToken); err == nil {
+			t.Fatalf("%s: token of a removed key verified", alg)
+		}
+		// the migration window is over
+		config.Config.TokenPolicy.SigningKey.AcceptSecretUntil = ""
+		if _, _, err := GetToken(legacy); err == nil {
+			t.Fatalf("%s: token signed by the secret verified", alg)
+		}
+		config.Config.TokenPolicy.SigningKey.AcceptSecretUntil = time.Now().Add(time.Hour).Format(time.RFC3339)
+	}
+	SetKeys(nil, nil)
+	if _, err := GetUserToken(legacy); err != nil {
+		t.Fatalf("hs256: %v", err)
+	}
+}
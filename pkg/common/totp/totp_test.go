@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B, SHA1 test vectors truncated to 6 digits.
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, want := range cases {
+		code, err := Code(secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != want {
+			t.Errorf("time %d: got %s, want %s", unix, code, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	code, err := Code(secret, now.Add(-Period*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	step, ok := Validate(secret, code, now, 0)
+	if !ok {
+		t.Fatal("previous step code rejected")
+	}
+	if _, ok := Validate(secret, code, now, step); ok {
+		t.Fatal("code accepted twice")
+	}
+	if _, ok := Validate(secret, "000000x", now, 0); ok {
+		t.Fatal("malformed code accepted")
+	}
+}
+
+func TestRecoveryCode(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("got %d codes", len(codes))
+	}
+	if HashRecoveryCode(codes[0]) != HashRecoveryCode(" "+codes[0][:5]+codes[0][6:]) {
+		t.Fatal("recovery code normalisation mismatch")
+	}
+}
@@ -31,4 +31,16 @@ var (
 	ErrForbidden                = errs.NewCodeError(20012, "Forbidden")                // 限制登录注册
 	ErrRefuseFriend             = errs.NewCodeError(20013, "RefuseFriend")             // 拒绝添加好友
 	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")     // 邮箱已经注册
+
+	ErrTwoFactorCodeNotMatch     = errs.NewCodeError(20015, "TwoFactorCodeNotMatch")     // 两步验证码错误
+	ErrTwoFactorChallengeExpired = errs.NewCodeError(20016, "TwoFactorChallengeExpired") // 两步验证登录已过期
+	ErrTwoFactorEnabled          = errs.NewCodeError(20017, "TwoFactorEnabled")          // 两步验证已经开启
+	ErrTwoFactorRequired         = errs.NewCodeError(20018, "TwoFactorRequired")         // 必须开启两步验证
+	ErrAccountLocked             = errs.NewCodeError(20019, "AccountLocked")             // 登录失败次数过多已锁定
+	ErrLoginLinkInvalid          = errs.NewCodeError(20020, "LoginLinkInvalid")          // 登录链接无效或已过期
+	ErrIdentityInvalid           = errs.NewCodeError(20021, "IdentityInvalid")           // 第三方身份校验失败
+	ErrPasswordReused            = errs.NewCodeError(20022, "PasswordReused")            // 不能使用最近用过的密码
+	ErrDataExportLinkInvalid     = errs.NewCodeError(20023, "DataExportLinkInvalid")     // 数据导出下载链接无效或已过期
+	ErrCaptchaRequired           = errs.NewCodeError(20024, "CaptchaRequired")           // 发送验证码前需要完成人机验证
+	ErrCaptchaNotMatch           = errs.NewCodeError(20025, "CaptchaNotMatch")           // 人机验证失败或已过期
 )
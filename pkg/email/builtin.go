@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+// titlePrefix starts the built-in subjects with verifyCode.mail.title when it is set.
+const titlePrefix = "{{if .Title}}{{.Title}} - {{end}}"
+
+// paragraph is a template whose text part is the html body without the markup.
+func paragraph(subject string, body string) Template {
+	return Template{Subject: titlePrefix + subject, HTML: "<p>" + body + "</p>", Text: body}
+}
+
+var builtin = map[string]map[string]Template{
+	PurposeRegister: {
+		"zh-CN": paragraph("注册验证码", "您的注册验证码为:{{.Code}}，该验证码{{.ValidMinutes}}分钟内有效，请勿泄露于他人。"),
+		"en":    paragraph("Verification code", "Your registration code is {{.Code}}. It is valid for {{.ValidMinutes}} minutes, do not share it with anyone."),
+	},
+	PurposeLogin: {
+		"zh-CN": paragraph("登录验证码", "您的登录验证码为:{{.Code}}，该验证码{{.ValidMinutes}}分钟内有效，请勿泄露于他人。"),
+		"en":    paragraph("Login code", "Your login code is {{.Code}}. It is valid for {{.ValidMinutes}} minutes, do not share it with anyone."),
+	},
+	PurposeReset: {
+		"zh-CN": paragraph("重置密码验证码", "您正在重置密码，验证码为:{{.Code}}，该验证码{{.ValidMinutes}}分钟内有效。如非本人操作，请忽略本邮件。"),
+		"en":    paragraph("Password reset code", "Your password reset code is {{.Code}}. It is valid for {{.ValidMinutes}} minutes. If you did not ask for it, ignore this mail."),
+	},
+	PurposeChangeIdentity: {
+		"zh-CN": paragraph("更换绑定验证码", "您正在更换账号绑定的手机号或邮箱，验证码为:{{.Code}}，该验证码{{.ValidMinutes}}分钟内有效，请勿泄露于他人。"),
+		"en":    paragraph("Verification code", "Your code to change the phone number or email of your account is {{.Code}}. It is valid for {{.ValidMinutes}} minutes, do not share it with anyone."),
+	},
+	PurposeLoginLink: {
+		"zh-CN": {
+			Subject: titlePrefix + "登录链接",
+			HTML:    `<p>请点击<a href="{{.Link}}">此链接</a>登录，该链接{{.ValidMinutes}}分钟内有效且只能使用一次，请勿泄露于他人。</p>`,
+			Text:    "请打开以下链接登录，该链接{{.ValidMinutes}}分钟内有效且只能使用一次，请勿泄露于他人。\n{{.Link}}",
+		},
+		"en": {
+			Subject: titlePrefix + "Login link",
+			HTML:    `<p>Click <a href="{{.Link}}">this link</a> to log in. It is valid for {{.ValidMinutes}} minutes and can be used once, do not share it with anyone.</p>`,
+			Text:    "Open this link to log in. It is valid for {{.ValidMinutes}} minutes and can be used once, do not share it with anyone.\n{{.Link}}",
+		},
+	},
+	PurposeAccountLocked: {
+		"zh-CN": paragraph("账号安全提醒", "您的账号因多次密码错误已被锁定{{.LockMinutes}}分钟，如非本人操作，请尽快修改密码。"),
+		"en":    paragraph("Security alert", "Your account is locked for {{.LockMinutes}} minutes after too many wrong passwords. If it was not you, change your password as soon as possible."),
+	},
+	PurposeLoginAlert: {
+		"zh-CN": paragraph("账号安全提醒", "您的账号于{{.Time}}在新的设备或网络登录，IP:{{.IP}}，地点:{{if .Location}}{{.Location}}{{else}}未知{{end}}。如非本人操作，请尽快修改密码。"),
+		"en":    paragraph("Security alert", "Your account logged in from a new device or network at {{.Time}}, IP: {{.IP}}, location: {{if .Location}}{{.Location}}{{else}}unknown{{end}}. If it was not you, change your password as soon as possible."),
+	},
+	PurposeEmailChanged: {
+		"zh-CN": paragraph("账号安全提醒", "您的账号于{{.Time}}将绑定邮箱更换为{{.NewEmail}}，此邮箱将不再用于登录。如非本人操作，请尽快联系管理员。"),
+		"en":    paragraph("Security alert", "The email of your account was changed to {{.NewEmail}} at {{.Time}}, this address can no longer be used to log in. If it was not you, contact the administrator as soon as possible."),
+	},
+}
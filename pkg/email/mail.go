@@ -16,43 +16,145 @@ package email
 
 import (
 	"context"
-	"fmt"
-	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"sort"
+	"time"
+
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
 	"gopkg.in/gomail.v2"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 )
 
-func NewMail() Mail {
+// TemplateStore holds the templates changed by admins, nil uses the built-in ones only.
+type TemplateStore interface {
+	FindMailTemplate(ctx context.Context, purpose string) ([]*chat.MailTemplate, error)
+}
+
+func NewMail(store TemplateStore) Mail {
 	dail := gomail.NewDialer(
 		config.Config.VerifyCode.Mail.SmtpAddr,
 		config.Config.VerifyCode.Mail.SmtpPort,
 		config.Config.VerifyCode.Mail.SenderMail,
 		config.Config.VerifyCode.Mail.SenderAuthorizationCode)
 
-	return &mail{dail: dail}
+	return &mail{dail: dail, store: store}
 }
 
+// Mail sends the mails of every purpose, locale is a language tag or an Accept-Language value.
 type Mail interface {
 	Name() string
-	SendMail(ctx context.Context, mail string, verifyCode string) error
+	// Send renders the template of the purpose in the locale and sends it.
+	Send(ctx context.Context, mail string, purpose string, locale string, data *Data) error
+	SendMail(ctx context.Context, mail string, purpose string, locale string, verifyCode string) error
+	SendAccountLocked(ctx context.Context, mail string, locale string, lockTime time.Duration) error
+	SendLoginLink(ctx context.Context, mail string, locale string, link string, expire time.Duration) error
+	SendLoginAlert(ctx context.Context, mail string, locale string, ip string, location string, loginTime time.Time) error
+	// SendEmailChanged notifies the old email, newEmail is masked by the caller.
+	SendEmailChanged(ctx context.Context, mail string, locale string, newEmail string, changeTime time.Time) error
 }
 
 type mail struct {
-	dail *gomail.Dialer
+	dail  *gomail.Dialer
+	store TemplateStore
 }
 
 func (a *mail) Name() string {
 	return "mail"
 }
 
-func (a *mail) SendMail(ctx context.Context, mail string, verifyCode string) error {
+// template picks the template of the locale, the ones changed by admins first.
+func (a *mail) template(ctx context.Context, purpose string, locale string) (*Template, error) {
+	templates := make(map[string]Template)
+	for l, t := range Builtin(purpose) {
+		templates[l] = t
+	}
+	if a.store != nil {
+		custom, err := a.store.FindMailTemplate(ctx, purpose)
+		if err != nil {
+			// the built-in templates still work without the database
+			log.ZError(ctx, "find mail template failed", err, "purpose", purpose)
+		}
+		for _, t := range custom {
+			templates[t.Locale] = Template{Subject: t.Subject, HTML: t.HTML, Text: t.Text}
+		}
+	}
+	if len(templates) == 0 {
+		return nil, errs.ErrInternalServer.Wrap("no mail template of " + purpose)
+	}
+	locales := make([]string, 0, len(templates))
+	for l := range templates {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+	matched := MatchLocale(locale, locales)
+	if matched == "" {
+		matched = MatchLocale(config.Config.VerifyCode.Mail.DefaultLocale, locales)
+	}
+	if matched == "" {
+		matched = MatchLocale(DefaultLocale, locales)
+	}
+	if matched == "" {
+		matched = locales[0]
+	}
+	t := templates[matched]
+	return &t, nil
+}
+
+func (a *mail) Send(ctx context.Context, mail string, purpose string, locale string, data *Data) error {
+	t, err := a.template(ctx, purpose, locale)
+	if err != nil {
+		return err
+	}
+	data.Title = config.Config.VerifyCode.Mail.Title
+	subject, html, text, err := Render(t, data)
+	if err != nil {
+		return err
+	}
 	m := gomail.NewMessage()
 	m.SetHeader(`From`, config.Config.VerifyCode.Mail.SenderMail)
 	m.SetHeader(`To`, []string{mail}...)
-	m.SetHeader(`Subject`, config.Config.VerifyCode.Mail.Title)
-	m.SetBody(`text/html`, fmt.Sprintf("您的验证码为:%s，该验证码5分钟内有效，请勿泄露于他人。", verifyCode))
+	m.SetHeader(`Subject`, subject)
+	switch {
+	case text != "" && html != "":
+		m.SetBody(`text/plain`, text)
+		m.AddAlternative(`text/html`, html)
+	case html != "":
+		m.SetBody(`text/html`, html)
+	default:
+		m.SetBody(`text/plain`, text)
+	}
 
-	// Send
-	err := a.dail.DialAndSend(m)
+	err = a.dail.DialAndSend(m)
 	return errs.Wrap(err)
 }
+
+// validMinutes is the validity of the verify codes shown in the mails.
+func validMinutes() int {
+	minutes := (config.Config.VerifyCode.ValidTime + 59) / 60
+	if minutes <= 0 {
+		return 1
+	}
+	return minutes
+}
+
+func (a *mail) SendMail(ctx context.Context, mail string, purpose string, locale string, verifyCode string) error {
+	return a.Send(ctx, mail, purpose, locale, &Data{Code: verifyCode, ValidMinutes: validMinutes()})
+}
+
+func (a *mail) SendAccountLocked(ctx context.Context, mail string, locale string, lockTime time.Duration) error {
+	return a.Send(ctx, mail, PurposeAccountLocked, locale, &Data{LockMinutes: int(lockTime.Minutes())})
+}
+
+func (a *mail) SendLoginLink(ctx context.Context, mail string, locale string, link string, expire time.Duration) error {
+	return a.Send(ctx, mail, PurposeLoginLink, locale, &Data{Link: link, ValidMinutes: int(expire.Minutes())})
+}
+
+func (a *mail) SendLoginAlert(ctx context.Context, mail string, locale string, ip string, location string, loginTime time.Time) error {
+	return a.Send(ctx, mail, PurposeLoginAlert, locale, &Data{IP: ip, Location: location, Time: loginTime.Format("2006-01-02 15:04:05")})
+}
+
+func (a *mail) SendEmailChanged(ctx context.Context, mail string, locale string, newEmail string, changeTime time.Time) error {
+	return a.Send(ctx, mail, PurposeEmailChanged, locale, &Data{NewEmail: newEmail, Time: changeTime.Format("2006-01-02 15:04:05")})
+}
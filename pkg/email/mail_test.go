@@ -49,11 +49,11 @@ func TestEmail(T *testing.T) {
 			want: errors.New("dial tcp :0: connectex: The requested address is not valid in its context."),
 		},
 	}
-	mail := NewMail()
+	mail := NewMail(nil)
 
 	for _, tt := range tests {
 		T.Run(tt.name, func(t *testing.T) {
-			if got := mail.SendMail(tt.ctx, tt.mail, tt.code); errors.Is(got, tt.want) {
+			if got := mail.SendMail(tt.ctx, tt.mail, PurposeRegister, "", tt.code); errors.Is(got, tt.want) {
 				t.Errorf("%v have a err,%v", tt.name, tt.want)
 			}
 		})
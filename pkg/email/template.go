@@ -0,0 +1,195 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+// Purposes of the mails, each one has templates per locale.
+const (
+	PurposeRegister       = "register"
+	PurposeLogin          = "login"
+	PurposeReset          = "reset"
+	PurposeChangeIdentity = "changeIdentity"
+	PurposeLoginLink      = "loginLink"
+	PurposeAccountLocked  = "accountLocked"
+	PurposeLoginAlert     = "loginAlert"
+	PurposeEmailChanged   = "emailChanged"
+)
+
+// DefaultLocale is used when neither the user nor the config picks one the purpose has.
+const DefaultLocale = "zh-CN"
+
+// Template is the subject and the parts of a mail. Subject and Text are text/template,
+// HTML is html/template, all of them executed with Data. An empty part is not sent.
+type Template struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Data is what the templates can use, the fields set depend on the purpose.
+type Data struct {
+	Title        string // verifyCode.mail.title
+	Code         string // register, login, reset, changeIdentity
+	Link         string // loginLink
+	ValidMinutes int    // validity of the code or of the link
+	LockMinutes  int    // accountLocked
+	IP           string // loginAlert
+	Location     string // loginAlert, may be empty
+	Time         string // loginAlert, emailChanged
+	NewEmail     string // emailChanged, masked
+}
+
+// Purposes returns every purpose with built-in templates.
+func Purposes() []string {
+	purposes := make([]string, 0, len(builtin))
+	for purpose := range builtin {
+		purposes = append(purposes, purpose)
+	}
+	sort.Strings(purposes)
+	return purposes
+}
+
+// Builtin returns the built-in templates of the purpose by locale.
+func Builtin(purpose string) map[string]Template {
+	return builtin[purpose]
+}
+
+// Render executes the template, a missing field is an error.
+func Render(t *Template, data *Data) (subject string, html string, text string, err error) {
+	if subject, err = renderText(t.Subject, data); err != nil {
+		return
+	}
+	if text, err = renderText(t.Text, data); err != nil {
+		return
+	}
+	if t.HTML != "" {
+		var tmpl *htmltemplate.Template
+		tmpl, err = htmltemplate.New("html").Option("missingkey=error").Parse(t.HTML)
+		if err != nil {
+			return "", "", "", errs.Wrap(err, "html")
+		}
+		var buf bytes.Buffer
+		if err = tmpl.Execute(&buf, data); err != nil {
+			return "", "", "", errs.Wrap(err, "html")
+		}
+		html = buf.String()
+	}
+	return
+}
+
+func renderText(text string, data *Data) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := template.New("text").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return buf.String(), nil
+}
+
+// Validate renders the template with sample data, so a broken template is refused before it is saved.
+func Validate(t *Template) error {
+	if t.Subject == "" {
+		return errs.ErrArgs.Wrap("subject is empty")
+	}
+	if t.HTML == "" && t.Text == "" {
+		return errs.ErrArgs.Wrap("html and text are empty")
+	}
+	_, _, _, err := Render(t, &Data{
+		Title:        "OpenIM",
+		Code:         "123456",
+		Link:         "https://example.com/login?token=token",
+		ValidMinutes: 5,
+		LockMinutes:  5,
+		IP:           "127.0.0.1",
+		Location:     "-",
+		Time:         "2006-01-02 15:04:05",
+		NewEmail:     "u***@example.com",
+	})
+	if err != nil {
+		return errs.ErrArgs.Wrap(err.Error())
+	}
+	return nil
+}
+
+// MatchLocale returns the first locale of the Accept-Language style list that is one of locales,
+// trying the base language of a tag when the tag itself is missing. Empty if none matches.
+func MatchLocale(accept string, locales []string) string {
+	for _, tag := range parseAcceptLanguage(accept) {
+		for _, locale := range locales {
+			if strings.EqualFold(locale, tag) {
+				return locale
+			}
+		}
+		base := baseLanguage(tag)
+		for _, locale := range locales {
+			if strings.EqualFold(baseLanguage(locale), base) {
+				return locale
+			}
+		}
+	}
+	return ""
+}
+
+// parseAcceptLanguage returns the tags ordered by their q value, such as "en-US,en;q=0.9,zh;q=0.8".
+func parseAcceptLanguage(accept string) []string {
+	type tag struct {
+		name string
+		q    float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ReplaceAll(strings.TrimSpace(name), "_", "-")
+		if name == "" || name == "*" {
+			continue
+		}
+		q := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			if f, err := strconv.ParseFloat(strings.TrimPrefix(params, "q="), 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			tags = append(tags, tag{name: name, q: q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.name)
+	}
+	return names
+}
+
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return strings.ToLower(base)
+}
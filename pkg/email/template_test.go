@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatchLocale(t *testing.T) {
+	locales := []string{"en", "zh-CN"}
+	cases := map[string]string{
+		"":                              "",
+		"zh-CN":                         "zh-CN",
+		"zh":                            "zh-CN",
+		"en-US,en;q=0.9":                "en",
+		"fr;q=0.9,zh-TW;q=0.8,en;q=0.1": "zh-CN",
+		"de":                            "",
+		"en;q=0,zh_cn":                  "zh-CN",
+	}
+	for accept, want := range cases {
+		if got := MatchLocale(accept, locales); got != want {
+			t.Errorf("MatchLocale(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	for _, purpose := range Purposes() {
+		for locale, tmpl := range Builtin(purpose) {
+			if err := Validate(&tmpl); err != nil {
+				t.Errorf("builtin %s %s: %v", purpose, locale, err)
+			}
+		}
+	}
+	tmpl := Template{Subject: "{{.Title}} code", HTML: "<b>{{.Code}}</b>", Text: "code {{.Code}}"}
+	subject, html, text, err := Render(&tmpl, &Data{Title: "<OpenIM>", Code: "<1>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != "<OpenIM> code" || text != "code <1>" {
+		t.Errorf("subject %q text %q", subject, text)
+	}
+	if !strings.Contains(html, "&lt;1&gt;") {
+		t.Errorf("html is not escaped: %q", html)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	bad := []Template{
+		{HTML: "{{.Code}}"},
+		{Subject: "code"},
+		{Subject: "code", Text: "{{.Unknown}}"},
+		{Subject: "code", HTML: "{{.Code"},
+	}
+	for i := range bad {
+		if err := Validate(&bad[i]); err == nil {
+			t.Errorf("template %d is valid", i)
+		}
+	}
+}
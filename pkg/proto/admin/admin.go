@@ -16,6 +16,7 @@ package admin
 
 import (
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/iprule"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/utils"
 )
@@ -174,6 +175,17 @@ func (x *AddUserIPLimitLoginReq) Check() error {
 	if x.Limits == nil {
 		return errs.ErrArgs.Wrap("limits is empty")
 	}
+	for _, limit := range x.Limits {
+		if limit.UserID == "" {
+			return errs.ErrArgs.Wrap("userID is empty")
+		}
+		if _, err := iprule.ParseRule(limit.Ip); err != nil {
+			return errs.ErrArgs.Wrap(err.Error())
+		}
+		if limit.ExpireTime < 0 {
+			return errs.ErrArgs.Wrap("expireTime is invalid")
+		}
+	}
 	return nil
 }
 
@@ -201,6 +213,14 @@ func (x *AddIPForbiddenReq) Check() error {
 	if x.Forbiddens == nil {
 		return errs.ErrArgs.Wrap("forbiddens is empty")
 	}
+	for _, forbidden := range x.Forbiddens {
+		if _, err := iprule.ParseRule(forbidden.Ip); err != nil {
+			return errs.ErrArgs.Wrap(err.Error())
+		}
+		if forbidden.ExpireTime < 0 {
+			return errs.ErrArgs.Wrap("expireTime is invalid")
+		}
+	}
 	return nil
 }
 
@@ -283,6 +303,36 @@ func (x *ParseTokenReq) Check() error {
 	return nil
 }
 
+func (x *GetSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.Current && x.Token == "" {
+		return errs.ErrArgs.Wrap("token is empty")
+	}
+	if len(x.SessionIDs) == 0 && !x.Current && !x.Others {
+		return errs.ErrArgs.Wrap("sessionIDs is empty")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errs.ErrArgs.Wrap("refreshToken is empty")
+	}
+	if x.UserType > constant.AdminUser || x.UserType < constant.NormalUser {
+		return errs.ErrArgs.Wrap("userType is invalid")
+	}
+	return nil
+}
+
 func (x *AddAppletReq) Check() error {
 	if x.Name == "" {
 		return errs.ErrArgs.Wrap("name is empty")
@@ -387,3 +437,214 @@ func (x *SearchAdminAccountReq) Check() error {
 	}
 	return nil
 }
+
+func (x *EnableTwoFactorReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *DisableTwoFactorReq) Check() error {
+	if x.Code == "" && x.RecoveryCode == "" {
+		return errs.ErrArgs.Wrap("code or recovery code must be set")
+	}
+	return nil
+}
+
+func (x *GenTwoFactorRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *TwoFactorLoginReq) Check() error {
+	if x.Challenge == "" {
+		return errs.ErrArgs.Wrap("challenge is empty")
+	}
+	if x.Code == "" && x.RecoveryCode == "" {
+		return errs.ErrArgs.Wrap("code or recovery code must be set")
+	}
+	return nil
+}
+
+func (x *ResetAdminTwoFactorReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *SearchAdminLoginIPReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AddAdminLoginIPReq) Check() error {
+	if len(x.Ips) == 0 {
+		return errs.ErrArgs.Wrap("ips is empty")
+	}
+	return nil
+}
+
+func (x *DelAdminLoginIPReq) Check() error {
+	if len(x.Ips) == 0 {
+		return errs.ErrArgs.Wrap("ips is empty")
+	}
+	return nil
+}
+
+func (x *UnlockAdminLoginReq) Check() error {
+	if len(x.UserIDs) == 0 && len(x.Ips) == 0 {
+		return errs.ErrArgs.Wrap("userIDs and ips are empty")
+	}
+	return nil
+}
+
+func (x *AddApiKeyReq) Check() error {
+	if x.Name == "" {
+		return errs.ErrArgs.Wrap("name is empty")
+	}
+	if len(x.Scopes) == 0 {
+		return errs.ErrArgs.Wrap("scopes is empty")
+	}
+	return nil
+}
+
+func (x *SearchApiKeyReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *DelApiKeyReq) Check() error {
+	if len(x.KeyIDs) == 0 {
+		return errs.ErrArgs.Wrap("keyIDs is empty")
+	}
+	return nil
+}
+
+func (x *CheckApiKeyReq) Check() error {
+	if x.ApiKey == "" {
+		return errs.ErrArgs.Wrap("apiKey is empty")
+	}
+	if x.Scope == "" {
+		return errs.ErrArgs.Wrap("scope is empty")
+	}
+	return nil
+}
+
+func checkPermissions(permissions []string) error {
+	if utils.Duplicate(permissions) {
+		return errs.ErrArgs.Wrap("permissions is duplicate")
+	}
+	for _, permission := range permissions {
+		if !utils.Contain(permission, constant.Permissions...) {
+			return errs.ErrArgs.Wrap("unknown permission " + permission)
+		}
+	}
+	return nil
+}
+
+func (x *AddRoleReq) Check() error {
+	if x.Name == "" {
+		return errs.ErrArgs.Wrap("name is empty")
+	}
+	if len(x.Permissions) == 0 {
+		return errs.ErrArgs.Wrap("permissions is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *UpdateRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.Wrap("roleID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errs.ErrArgs.Wrap("name is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *DelRoleReq) Check() error {
+	if len(x.RoleIDs) == 0 {
+		return errs.ErrArgs.Wrap("roleIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchRoleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SetAdminRoleReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if utils.Duplicate(x.RoleIDs) {
+		return errs.ErrArgs.Wrap("roleIDs is duplicate")
+	}
+	return nil
+}
+
+func (x *FindAdminRoleReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.Wrap("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *CheckPermissionReq) Check() error {
+	if x.Permission == "" {
+		return errs.ErrArgs.Wrap("permission is empty")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 {
+		return errs.ErrArgs.Wrap("time is invalid")
+	}
+	return nil
+}
+
+func (x *VerifyAuditLogReq) Check() error {
+	if x.FromSeq < 0 {
+		return errs.ErrArgs.Wrap("fromSeq is invalid")
+	}
+	return nil
+}
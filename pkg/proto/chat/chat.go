@@ -65,7 +65,7 @@ func (x *FindUserFullInfoReq) Check() error {
 }
 
 func (x *SendVerifyCodeReq) Check() error {
-	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForLogin {
+	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForChangeIdentity {
 		return errs.ErrArgs.Wrap("usedFor flied is empty")
 	}
 	if x.Email == "" {
@@ -143,6 +143,9 @@ func (x *LoginReq) Check() error {
 	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
 		return errs.ErrArgs.Wrap("platform is invalid")
 	}
+	if x.LdapPassword != "" && (x.Account == "" || x.Password != "" || x.VerifyCode != "") {
+		return errs.ErrArgs.Wrap("ldap login only takes the account and ldapPassword")
+	}
 	//if x.Email == "" {
 	//	if x.AreaCode == "" {
 	//		return errs.ErrArgs.Wrap("AreaCode is empty")
@@ -260,6 +263,29 @@ func (x *SearchLogsReq) Check() error {
 	return nil
 }
 
+func (x *SetMustChangePasswordReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.Wrap("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *SearchUserLoginRecordReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("Pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	if x.EndTime != 0 && x.StartTime > x.EndTime {
+		return errs.ErrArgs.Wrap("startTime>endTime")
+	}
+	return nil
+}
+
 func EmailCheck(email string) error {
 	pattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
 	if err := regexMatch(pattern, email); err != nil {
@@ -335,3 +361,149 @@ func (x *AddUserAccountReq) Check() error {
 
 	return nil
 }
+
+func (x *EnableTwoFactorReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *DisableTwoFactorReq) Check() error {
+	if x.Code == "" && x.RecoveryCode == "" {
+		return errs.ErrArgs.Wrap("code or recovery code must be set")
+	}
+	return nil
+}
+
+func (x *GenTwoFactorRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *TwoFactorLoginReq) Check() error {
+	if x.Challenge == "" {
+		return errs.ErrArgs.Wrap("challenge is empty")
+	}
+	if x.Code == "" && x.RecoveryCode == "" {
+		return errs.ErrArgs.Wrap("code or recovery code must be set")
+	}
+	return nil
+}
+
+func (x *ResetUserTwoFactorReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *UnlockLoginReq) Check() error {
+	if len(x.UserIDs) == 0 && len(x.Ips) == 0 {
+		return errs.ErrArgs.Wrap("userIDs and ips are empty")
+	}
+	return nil
+}
+
+func (x *SendLoginLinkReq) Check() error {
+	if err := EmailCheck(x.Email); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (x *LoginByLinkReq) Check() error {
+	if x.Token == "" {
+		return errs.ErrArgs.Wrap("token is empty")
+	}
+	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
+		return errs.ErrArgs.Wrap("platform is invalid")
+	}
+	return nil
+}
+
+func (x *GetOIDCAuthURLReq) Check() error {
+	if x.Provider == "" {
+		return errs.ErrArgs.Wrap("provider is empty")
+	}
+	return nil
+}
+
+func (x *LoginByOIDCReq) Check() error {
+	if x.State == "" {
+		return errs.ErrArgs.Wrap("state is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
+		return errs.ErrArgs.Wrap("platform is invalid")
+	}
+	return nil
+}
+
+func (x *FindExternalIdentityReq) Check() error {
+	if x.Provider == "" {
+		return errs.ErrArgs.Wrap("provider is empty")
+	}
+	return nil
+}
+
+func (x *AddExternalIdentityReq) Check() error {
+	if x.Identity == nil {
+		return errs.ErrArgs.Wrap("identity is nil")
+	}
+	if x.Identity.Provider == "" || x.Identity.Subject == "" || x.Identity.UserID == "" {
+		return errs.ErrArgs.Wrap("provider, subject and userID must be set")
+	}
+	return nil
+}
+
+func (x *DownloadDataExportReq) Check() error {
+	if x.Token == "" {
+		return errs.ErrArgs.Wrap("token is empty")
+	}
+	return nil
+}
+
+func (x *ChangeIdentityReq) Check() error {
+	if x.Email == "" {
+		if x.AreaCode == "" {
+			return errs.ErrArgs.Wrap("AreaCode is empty")
+		} else if err := AreaCodeCheck(x.AreaCode); err != nil {
+			return err
+		}
+		if x.PhoneNumber == "" {
+			return errs.ErrArgs.Wrap("PhoneNumber is empty")
+		} else if err := PhoneNumberCheck(x.PhoneNumber); err != nil {
+			return err
+		}
+	} else {
+		if err := EmailCheck(x.Email); err != nil {
+			return err
+		}
+	}
+	if x.VerifyCode == "" {
+		return errs.ErrArgs.Wrap("verifyCode is empty")
+	}
+	return nil
+}
+
+func (x *SetMailTemplateReq) Check() error {
+	if x.Template == nil {
+		return errs.ErrArgs.Wrap("template is nil")
+	}
+	if x.Template.Purpose == "" || x.Template.Locale == "" {
+		return errs.ErrArgs.Wrap("purpose and locale must be set")
+	}
+	return nil
+}
+
+func (x *DelMailTemplateReq) Check() error {
+	if x.Purpose == "" || x.Locale == "" {
+		return errs.ErrArgs.Wrap("purpose and locale must be set")
+	}
+	return nil
+}
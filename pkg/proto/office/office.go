@@ -18,3 +18,17 @@ func (x *FindRelevantWorkMomentsReq) Check() error {
 	}
 	return nil
 }
+
+func (x *DeleteUserDataReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errs.ErrArgs.Wrap("userIDs is empty")
+	}
+	return nil
+}
+
+func (x *ExportUserDataReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
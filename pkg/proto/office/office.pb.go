@@ -3028,6 +3028,201 @@ func (*ReadWorkMomentsResp) Descriptor() ([]byte, []int) {
 	return file_office_office_proto_rawDescGZIP(), []int{48}
 }
 
+type DeleteUserDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *DeleteUserDataReq) Reset() {
+	*x = DeleteUserDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_office_office_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataReq) ProtoMessage() {}
+
+func (x *DeleteUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_office_office_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataReq) Descriptor() ([]byte, []int) {
+	return file_office_office_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteUserDataReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type DeleteUserDataResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserDataResp) Reset() {
+	*x = DeleteUserDataResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_office_office_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserDataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserDataResp) ProtoMessage() {}
+
+func (x *DeleteUserDataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_office_office_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserDataResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataResp) Descriptor() ([]byte, []int) {
+	return file_office_office_proto_rawDescGZIP(), []int{50}
+}
+
+type ExportUserDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *ExportUserDataReq) Reset() {
+	*x = ExportUserDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_office_office_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataReq) ProtoMessage() {}
+
+func (x *ExportUserDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_office_office_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataReq.ProtoReflect.Descriptor instead.
+func (*ExportUserDataReq) Descriptor() ([]byte, []int) {
+	return file_office_office_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportUserDataReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ExportUserDataResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags        []*Tag        `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags"`
+	TagSendLogs []*TagSendLog `protobuf:"bytes,2,rep,name=tagSendLogs,proto3" json:"tagSendLogs"`
+	WorkMoments []*WorkMoment `protobuf:"bytes,3,rep,name=workMoments,proto3" json:"workMoments"` // with their comments and likes
+}
+
+func (x *ExportUserDataResp) Reset() {
+	*x = ExportUserDataResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_office_office_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUserDataResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataResp) ProtoMessage() {}
+
+func (x *ExportUserDataResp) ProtoReflect() protoreflect.Message {
+	mi := &file_office_office_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataResp.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResp) Descriptor() ([]byte, []int) {
+	return file_office_office_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ExportUserDataResp) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExportUserDataResp) GetTagSendLogs() []*TagSendLog {
+	if x != nil {
+		return x.TagSendLogs
+	}
+	return nil
+}
+
+func (x *ExportUserDataResp) GetWorkMoments() []*WorkMoment {
+	if x != nil {
+		return x.WorkMoments
+	}
+	return nil
+}
+
 type WorkMomentNotificationMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3045,7 +3240,7 @@ type WorkMomentNotificationMsg struct {
 func (x *WorkMomentNotificationMsg) Reset() {
 	*x = WorkMomentNotificationMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_office_office_proto_msgTypes[49]
+		mi := &file_office_office_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3058,7 +3253,7 @@ func (x *WorkMomentNotificationMsg) String() string {
 func (*WorkMomentNotificationMsg) ProtoMessage() {}
 
 func (x *WorkMomentNotificationMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_office_office_proto_msgTypes[49]
+	mi := &file_office_office_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3071,7 +3266,7 @@ func (x *WorkMomentNotificationMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkMomentNotificationMsg.ProtoReflect.Descriptor instead.
 func (*WorkMomentNotificationMsg) Descriptor() ([]byte, []int) {
-	return file_office_office_proto_rawDescGZIP(), []int{49}
+	return file_office_office_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *WorkMomentNotificationMsg) GetNotificationMsgType() int32 {
@@ -3503,148 +3698,179 @@ var file_office_office_proto_rawDesc = []byte{
 	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
 	0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x9f, 0x02, 0x0a, 0x19, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
-	0x30, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x4d,
-	0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
-	0x65, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x32, 0xf8, 0x0e, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x12, 0x54,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x12, 0x21, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
-	0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66,
-	0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61,
-	0x67, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52,
-	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61,
-	0x67, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52,
-	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1c,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69,
-	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x4f,
+	0x70, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x53, 0x65, 0x6e,
+	0x64, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e,
+	0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x0b, 0x74, 0x61, 0x67, 0x53,
+	0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x4d,
+	0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f,
 	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
-	0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0b, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x32, 0x54, 0x61, 0x67, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x32, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x19, 0x57, 0x6f, 0x72,
+	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x3e, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x32, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c,
-	0x6f, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x53, 0x65,
-	0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f,
-	0x67, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64,
-	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54, 0x61,
-	0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x42, 0x79, 0x49, 0x44, 0x12, 0x24,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xb6, 0x10, 0x0a, 0x06, 0x4f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
 	0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x54, 0x61, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x13, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x09, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x06, 0x53,
+	0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x32, 0x54, 0x61,
+	0x67, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x32, 0x54, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67,
+	0x32, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x54, 0x61,
+	0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
 	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
-	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d,
-	0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x13, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66,
-	0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x54, 0x61, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61,
+	0x67, 0x42, 0x79, 0x49, 0x44, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x61, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70,
 	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x4f,
-	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x4f,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x61, 0x67, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x6c, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66,
+	0x0a, 0x11, 0x4c, 0x69, 0x6b, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4f, 0x6e, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f,
 	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
 	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x4f, 0x6e,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x6f, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
-	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
-	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
-	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x65, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6f, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
 	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69,
-	0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x6f,
-	0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b,
-	0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x76, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
+	0x63, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
 	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
 	0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x63, 0x76, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x72, 0x53, 0x65, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x63, 0x76, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x78, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61,
-	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
-	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x4f,
+	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x6e, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x75, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x76, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x4f,
 	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7e, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x4f, 0x70, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x76, 0x57, 0x6f, 0x72, 0x6b,
+	0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65,
 	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0f,
-	0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66,
-	0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x63, 0x76, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x78, 0x0a, 0x17, 0x46, 0x69, 0x6e,
+	0x64, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x7e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x57, 0x6f,
+	0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x30, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d,
+	0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
 	0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57,
-	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2c,
-	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x4d, 0x6f, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x63, 0x68, 0x61, 0x74,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3659,7 +3885,7 @@ func file_office_office_proto_rawDescGZIP() []byte {
 	return file_office_office_proto_rawDescData
 }
 
-var file_office_office_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_office_office_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_office_office_proto_goTypes = []interface{}{
 	(*TagUser)(nil),                       // 0: OpenIMChat.office.TagUser
 	(*Tag)(nil),                           // 1: OpenIMChat.office.Tag
@@ -3710,25 +3936,29 @@ var file_office_office_proto_goTypes = []interface{}{
 	(*GetUnreadWorkMomentsCountResp)(nil), // 46: OpenIMChat.office.GetUnreadWorkMomentsCountResp
 	(*ReadWorkMomentsReq)(nil),            // 47: OpenIMChat.office.ReadWorkMomentsReq
 	(*ReadWorkMomentsResp)(nil),           // 48: OpenIMChat.office.ReadWorkMomentsResp
-	(*WorkMomentNotificationMsg)(nil),     // 49: OpenIMChat.office.WorkMomentNotificationMsg
-	(*common.UserPublicInfo)(nil),         // 50: OpenIMChat.common.UserPublicInfo
-	(*sdkws.RequestPagination)(nil),       // 51: OpenIMServer.sdkws.RequestPagination
+	(*DeleteUserDataReq)(nil),             // 49: OpenIMChat.office.DeleteUserDataReq
+	(*DeleteUserDataResp)(nil),            // 50: OpenIMChat.office.DeleteUserDataResp
+	(*ExportUserDataReq)(nil),             // 51: OpenIMChat.office.ExportUserDataReq
+	(*ExportUserDataResp)(nil),            // 52: OpenIMChat.office.ExportUserDataResp
+	(*WorkMomentNotificationMsg)(nil),     // 53: OpenIMChat.office.WorkMomentNotificationMsg
+	(*common.UserPublicInfo)(nil),         // 54: OpenIMChat.common.UserPublicInfo
+	(*sdkws.RequestPagination)(nil),       // 55: OpenIMServer.sdkws.RequestPagination
 }
 var file_office_office_proto_depIdxs = []int32{
-	50, // 0: OpenIMChat.office.Tag.users:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 0: OpenIMChat.office.Tag.users:type_name -> OpenIMChat.common.UserPublicInfo
 	1,  // 1: OpenIMChat.office.GetUserTagsResp.tags:type_name -> OpenIMChat.office.Tag
-	50, // 2: OpenIMChat.office.SendMsg2TagResp.sendUser:type_name -> OpenIMChat.common.UserPublicInfo
-	51, // 3: OpenIMChat.office.GetTagSendLogsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	54, // 2: OpenIMChat.office.SendMsg2TagResp.sendUser:type_name -> OpenIMChat.common.UserPublicInfo
+	55, // 3: OpenIMChat.office.GetTagSendLogsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
 	1,  // 4: OpenIMChat.office.TagSendLog.tags:type_name -> OpenIMChat.office.Tag
-	50, // 5: OpenIMChat.office.TagSendLog.users:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 5: OpenIMChat.office.TagSendLog.users:type_name -> OpenIMChat.common.UserPublicInfo
 	13, // 6: OpenIMChat.office.GetTagSendLogsResp.tagSendLogs:type_name -> OpenIMChat.office.TagSendLog
 	1,  // 7: OpenIMChat.office.GetUserTagByIDResp.tag:type_name -> OpenIMChat.office.Tag
 	22, // 8: OpenIMChat.office.WorkMomentContent.metas:type_name -> OpenIMChat.office.Meta
 	23, // 9: OpenIMChat.office.WorkMoment.content:type_name -> OpenIMChat.office.WorkMomentContent
 	24, // 10: OpenIMChat.office.WorkMoment.likeUsers:type_name -> OpenIMChat.office.LikeUserInfo
 	19, // 11: OpenIMChat.office.WorkMoment.comments:type_name -> OpenIMChat.office.Comment
-	50, // 12: OpenIMChat.office.WorkMoment.permissionUsers:type_name -> OpenIMChat.common.UserPublicInfo
-	50, // 13: OpenIMChat.office.WorkMoment.atUsers:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 12: OpenIMChat.office.WorkMoment.permissionUsers:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 13: OpenIMChat.office.WorkMoment.atUsers:type_name -> OpenIMChat.common.UserPublicInfo
 	23, // 14: OpenIMChat.office.CreateOneWorkMomentReq.content:type_name -> OpenIMChat.office.WorkMomentContent
 	19, // 15: OpenIMChat.office.CreateOneWorkMomentReq.comments:type_name -> OpenIMChat.office.Comment
 	25, // 16: OpenIMChat.office.CreateOneWorkMomentResp.workMoment:type_name -> OpenIMChat.office.WorkMoment
@@ -3736,61 +3966,68 @@ var file_office_office_proto_depIdxs = []int32{
 	25, // 18: OpenIMChat.office.CommentOneWorkMomentResp.workMoment:type_name -> OpenIMChat.office.WorkMoment
 	25, // 19: OpenIMChat.office.DeleteCommentResp.workMoment:type_name -> OpenIMChat.office.WorkMoment
 	25, // 20: OpenIMChat.office.GetWorkMomentByIDResp.workMoment:type_name -> OpenIMChat.office.WorkMoment
-	51, // 21: OpenIMChat.office.GetUserSendWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	55, // 21: OpenIMChat.office.GetUserSendWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
 	25, // 22: OpenIMChat.office.GetUserSendWorkMomentsResp.workMoments:type_name -> OpenIMChat.office.WorkMoment
-	51, // 23: OpenIMChat.office.GetUserRecvWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	55, // 23: OpenIMChat.office.GetUserRecvWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
 	25, // 24: OpenIMChat.office.GetUserRecvWorkMomentsResp.workMoments:type_name -> OpenIMChat.office.WorkMoment
-	51, // 25: OpenIMChat.office.FindRelevantWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	55, // 25: OpenIMChat.office.FindRelevantWorkMomentsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
 	23, // 26: OpenIMChat.office.WorkMomentLog.content:type_name -> OpenIMChat.office.WorkMomentContent
 	24, // 27: OpenIMChat.office.WorkMomentLog.likeUsers:type_name -> OpenIMChat.office.LikeUserInfo
 	19, // 28: OpenIMChat.office.WorkMomentLog.comments:type_name -> OpenIMChat.office.Comment
-	50, // 29: OpenIMChat.office.WorkMomentLog.permissionUsers:type_name -> OpenIMChat.common.UserPublicInfo
-	50, // 30: OpenIMChat.office.WorkMomentLog.atUsers:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 29: OpenIMChat.office.WorkMomentLog.permissionUsers:type_name -> OpenIMChat.common.UserPublicInfo
+	54, // 30: OpenIMChat.office.WorkMomentLog.atUsers:type_name -> OpenIMChat.common.UserPublicInfo
 	43, // 31: OpenIMChat.office.FindRelevantWorkMomentsResp.workMoments:type_name -> OpenIMChat.office.WorkMomentLog
-	23, // 32: OpenIMChat.office.WorkMomentNotificationMsg.content:type_name -> OpenIMChat.office.WorkMomentContent
-	2,  // 33: OpenIMChat.office.Office.GetUserTags:input_type -> OpenIMChat.office.GetUserTagsReq
-	4,  // 34: OpenIMChat.office.Office.CreateTag:input_type -> OpenIMChat.office.CreateTagReq
-	6,  // 35: OpenIMChat.office.Office.DeleteTag:input_type -> OpenIMChat.office.DeleteTagReq
-	8,  // 36: OpenIMChat.office.Office.SetTag:input_type -> OpenIMChat.office.SetTagReq
-	10, // 37: OpenIMChat.office.Office.SendMsg2Tag:input_type -> OpenIMChat.office.SendMsg2TagReq
-	12, // 38: OpenIMChat.office.Office.GetTagSendLogs:input_type -> OpenIMChat.office.GetTagSendLogsReq
-	15, // 39: OpenIMChat.office.Office.DelTagSendLog:input_type -> OpenIMChat.office.DelTagSendLogReq
-	17, // 40: OpenIMChat.office.Office.GetUserTagByID:input_type -> OpenIMChat.office.GetUserTagByIDReq
-	26, // 41: OpenIMChat.office.Office.CreateOneWorkMoment:input_type -> OpenIMChat.office.CreateOneWorkMomentReq
-	28, // 42: OpenIMChat.office.Office.DeleteOneWorkMoment:input_type -> OpenIMChat.office.DeleteOneWorkMomentReq
-	30, // 43: OpenIMChat.office.Office.LikeOneWorkMoment:input_type -> OpenIMChat.office.LikeOneWorkMomentReq
-	32, // 44: OpenIMChat.office.Office.CommentOneWorkMoment:input_type -> OpenIMChat.office.CommentOneWorkMomentReq
-	34, // 45: OpenIMChat.office.Office.DeleteComment:input_type -> OpenIMChat.office.DeleteCommentReq
-	36, // 46: OpenIMChat.office.Office.GetWorkMomentByID:input_type -> OpenIMChat.office.GetWorkMomentByIDReq
-	38, // 47: OpenIMChat.office.Office.GetUserSendWorkMoments:input_type -> OpenIMChat.office.GetUserSendWorkMomentsReq
-	40, // 48: OpenIMChat.office.Office.GetUserRecvWorkMoments:input_type -> OpenIMChat.office.GetUserRecvWorkMomentsReq
-	42, // 49: OpenIMChat.office.Office.FindRelevantWorkMoments:input_type -> OpenIMChat.office.FindRelevantWorkMomentsReq
-	45, // 50: OpenIMChat.office.Office.GetUnreadWorkMomentsCount:input_type -> OpenIMChat.office.GetUnreadWorkMomentsCountReq
-	47, // 51: OpenIMChat.office.Office.ReadWorkMoments:input_type -> OpenIMChat.office.ReadWorkMomentsReq
-	3,  // 52: OpenIMChat.office.Office.GetUserTags:output_type -> OpenIMChat.office.GetUserTagsResp
-	5,  // 53: OpenIMChat.office.Office.CreateTag:output_type -> OpenIMChat.office.CreateTagResp
-	7,  // 54: OpenIMChat.office.Office.DeleteTag:output_type -> OpenIMChat.office.DeleteTagResp
-	9,  // 55: OpenIMChat.office.Office.SetTag:output_type -> OpenIMChat.office.SetTagResp
-	11, // 56: OpenIMChat.office.Office.SendMsg2Tag:output_type -> OpenIMChat.office.SendMsg2TagResp
-	14, // 57: OpenIMChat.office.Office.GetTagSendLogs:output_type -> OpenIMChat.office.GetTagSendLogsResp
-	16, // 58: OpenIMChat.office.Office.DelTagSendLog:output_type -> OpenIMChat.office.DelTagSendLogResp
-	18, // 59: OpenIMChat.office.Office.GetUserTagByID:output_type -> OpenIMChat.office.GetUserTagByIDResp
-	27, // 60: OpenIMChat.office.Office.CreateOneWorkMoment:output_type -> OpenIMChat.office.CreateOneWorkMomentResp
-	29, // 61: OpenIMChat.office.Office.DeleteOneWorkMoment:output_type -> OpenIMChat.office.DeleteOneWorkMomentResp
-	31, // 62: OpenIMChat.office.Office.LikeOneWorkMoment:output_type -> OpenIMChat.office.LikeOneWorkMomentResp
-	33, // 63: OpenIMChat.office.Office.CommentOneWorkMoment:output_type -> OpenIMChat.office.CommentOneWorkMomentResp
-	35, // 64: OpenIMChat.office.Office.DeleteComment:output_type -> OpenIMChat.office.DeleteCommentResp
-	37, // 65: OpenIMChat.office.Office.GetWorkMomentByID:output_type -> OpenIMChat.office.GetWorkMomentByIDResp
-	39, // 66: OpenIMChat.office.Office.GetUserSendWorkMoments:output_type -> OpenIMChat.office.GetUserSendWorkMomentsResp
-	41, // 67: OpenIMChat.office.Office.GetUserRecvWorkMoments:output_type -> OpenIMChat.office.GetUserRecvWorkMomentsResp
-	44, // 68: OpenIMChat.office.Office.FindRelevantWorkMoments:output_type -> OpenIMChat.office.FindRelevantWorkMomentsResp
-	46, // 69: OpenIMChat.office.Office.GetUnreadWorkMomentsCount:output_type -> OpenIMChat.office.GetUnreadWorkMomentsCountResp
-	48, // 70: OpenIMChat.office.Office.ReadWorkMoments:output_type -> OpenIMChat.office.ReadWorkMomentsResp
-	52, // [52:71] is the sub-list for method output_type
-	33, // [33:52] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	1,  // 32: OpenIMChat.office.ExportUserDataResp.tags:type_name -> OpenIMChat.office.Tag
+	13, // 33: OpenIMChat.office.ExportUserDataResp.tagSendLogs:type_name -> OpenIMChat.office.TagSendLog
+	25, // 34: OpenIMChat.office.ExportUserDataResp.workMoments:type_name -> OpenIMChat.office.WorkMoment
+	23, // 35: OpenIMChat.office.WorkMomentNotificationMsg.content:type_name -> OpenIMChat.office.WorkMomentContent
+	2,  // 36: OpenIMChat.office.Office.GetUserTags:input_type -> OpenIMChat.office.GetUserTagsReq
+	4,  // 37: OpenIMChat.office.Office.CreateTag:input_type -> OpenIMChat.office.CreateTagReq
+	6,  // 38: OpenIMChat.office.Office.DeleteTag:input_type -> OpenIMChat.office.DeleteTagReq
+	8,  // 39: OpenIMChat.office.Office.SetTag:input_type -> OpenIMChat.office.SetTagReq
+	10, // 40: OpenIMChat.office.Office.SendMsg2Tag:input_type -> OpenIMChat.office.SendMsg2TagReq
+	12, // 41: OpenIMChat.office.Office.GetTagSendLogs:input_type -> OpenIMChat.office.GetTagSendLogsReq
+	15, // 42: OpenIMChat.office.Office.DelTagSendLog:input_type -> OpenIMChat.office.DelTagSendLogReq
+	17, // 43: OpenIMChat.office.Office.GetUserTagByID:input_type -> OpenIMChat.office.GetUserTagByIDReq
+	26, // 44: OpenIMChat.office.Office.CreateOneWorkMoment:input_type -> OpenIMChat.office.CreateOneWorkMomentReq
+	28, // 45: OpenIMChat.office.Office.DeleteOneWorkMoment:input_type -> OpenIMChat.office.DeleteOneWorkMomentReq
+	30, // 46: OpenIMChat.office.Office.LikeOneWorkMoment:input_type -> OpenIMChat.office.LikeOneWorkMomentReq
+	32, // 47: OpenIMChat.office.Office.CommentOneWorkMoment:input_type -> OpenIMChat.office.CommentOneWorkMomentReq
+	34, // 48: OpenIMChat.office.Office.DeleteComment:input_type -> OpenIMChat.office.DeleteCommentReq
+	36, // 49: OpenIMChat.office.Office.GetWorkMomentByID:input_type -> OpenIMChat.office.GetWorkMomentByIDReq
+	38, // 50: OpenIMChat.office.Office.GetUserSendWorkMoments:input_type -> OpenIMChat.office.GetUserSendWorkMomentsReq
+	40, // 51: OpenIMChat.office.Office.GetUserRecvWorkMoments:input_type -> OpenIMChat.office.GetUserRecvWorkMomentsReq
+	42, // 52: OpenIMChat.office.Office.FindRelevantWorkMoments:input_type -> OpenIMChat.office.FindRelevantWorkMomentsReq
+	45, // 53: OpenIMChat.office.Office.GetUnreadWorkMomentsCount:input_type -> OpenIMChat.office.GetUnreadWorkMomentsCountReq
+	47, // 54: OpenIMChat.office.Office.ReadWorkMoments:input_type -> OpenIMChat.office.ReadWorkMomentsReq
+	49, // 55: OpenIMChat.office.Office.DeleteUserData:input_type -> OpenIMChat.office.DeleteUserDataReq
+	51, // 56: OpenIMChat.office.Office.ExportUserData:input_type -> OpenIMChat.office.ExportUserDataReq
+	3,  // 57: OpenIMChat.office.Office.GetUserTags:output_type -> OpenIMChat.office.GetUserTagsResp
+	5,  // 58: OpenIMChat.office.Office.CreateTag:output_type -> OpenIMChat.office.CreateTagResp
+	7,  // 59: OpenIMChat.office.Office.DeleteTag:output_type -> OpenIMChat.office.DeleteTagResp
+	9,  // 60: OpenIMChat.office.Office.SetTag:output_type -> OpenIMChat.office.SetTagResp
+	11, // 61: OpenIMChat.office.Office.SendMsg2Tag:output_type -> OpenIMChat.office.SendMsg2TagResp
+	14, // 62: OpenIMChat.office.Office.GetTagSendLogs:output_type -> OpenIMChat.office.GetTagSendLogsResp
+	16, // 63: OpenIMChat.office.Office.DelTagSendLog:output_type -> OpenIMChat.office.DelTagSendLogResp
+	18, // 64: OpenIMChat.office.Office.GetUserTagByID:output_type -> OpenIMChat.office.GetUserTagByIDResp
+	27, // 65: OpenIMChat.office.Office.CreateOneWorkMoment:output_type -> OpenIMChat.office.CreateOneWorkMomentResp
+	29, // 66: OpenIMChat.office.Office.DeleteOneWorkMoment:output_type -> OpenIMChat.office.DeleteOneWorkMomentResp
+	31, // 67: OpenIMChat.office.Office.LikeOneWorkMoment:output_type -> OpenIMChat.office.LikeOneWorkMomentResp
+	33, // 68: OpenIMChat.office.Office.CommentOneWorkMoment:output_type -> OpenIMChat.office.CommentOneWorkMomentResp
+	35, // 69: OpenIMChat.office.Office.DeleteComment:output_type -> OpenIMChat.office.DeleteCommentResp
+	37, // 70: OpenIMChat.office.Office.GetWorkMomentByID:output_type -> OpenIMChat.office.GetWorkMomentByIDResp
+	39, // 71: OpenIMChat.office.Office.GetUserSendWorkMoments:output_type -> OpenIMChat.office.GetUserSendWorkMomentsResp
+	41, // 72: OpenIMChat.office.Office.GetUserRecvWorkMoments:output_type -> OpenIMChat.office.GetUserRecvWorkMomentsResp
+	44, // 73: OpenIMChat.office.Office.FindRelevantWorkMoments:output_type -> OpenIMChat.office.FindRelevantWorkMomentsResp
+	46, // 74: OpenIMChat.office.Office.GetUnreadWorkMomentsCount:output_type -> OpenIMChat.office.GetUnreadWorkMomentsCountResp
+	48, // 75: OpenIMChat.office.Office.ReadWorkMoments:output_type -> OpenIMChat.office.ReadWorkMomentsResp
+	50, // 76: OpenIMChat.office.Office.DeleteUserData:output_type -> OpenIMChat.office.DeleteUserDataResp
+	52, // 77: OpenIMChat.office.Office.ExportUserData:output_type -> OpenIMChat.office.ExportUserDataResp
+	57, // [57:78] is the sub-list for method output_type
+	36, // [36:57] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_office_office_proto_init() }
@@ -4388,6 +4625,54 @@ func file_office_office_proto_init() {
 			}
 		}
 		file_office_office_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_office_office_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserDataResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_office_office_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_office_office_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUserDataResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_office_office_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkMomentNotificationMsg); i {
 			case 0:
 				return &v.state
@@ -4406,7 +4691,7 @@ func file_office_office_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_office_office_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   50,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4451,6 +4736,8 @@ type OfficeClient interface {
 	FindRelevantWorkMoments(ctx context.Context, in *FindRelevantWorkMomentsReq, opts ...grpc.CallOption) (*FindRelevantWorkMomentsResp, error)
 	GetUnreadWorkMomentsCount(ctx context.Context, in *GetUnreadWorkMomentsCountReq, opts ...grpc.CallOption) (*GetUnreadWorkMomentsCountResp, error)
 	ReadWorkMoments(ctx context.Context, in *ReadWorkMomentsReq, opts ...grpc.CallOption) (*ReadWorkMomentsResp, error)
+	DeleteUserData(ctx context.Context, in *DeleteUserDataReq, opts ...grpc.CallOption) (*DeleteUserDataResp, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataResp, error)
 }
 
 type officeClient struct {
@@ -4632,6 +4919,24 @@ func (c *officeClient) ReadWorkMoments(ctx context.Context, in *ReadWorkMomentsR
 	return out, nil
 }
 
+func (c *officeClient) DeleteUserData(ctx context.Context, in *DeleteUserDataReq, opts ...grpc.CallOption) (*DeleteUserDataResp, error) {
+	out := new(DeleteUserDataResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.office.Office/DeleteUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *officeClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (*ExportUserDataResp, error) {
+	out := new(ExportUserDataResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.office.Office/ExportUserData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OfficeServer is the server API for Office service.
 type OfficeServer interface {
 	GetUserTags(context.Context, *GetUserTagsReq) (*GetUserTagsResp, error)
@@ -4653,6 +4958,8 @@ type OfficeServer interface {
 	FindRelevantWorkMoments(context.Context, *FindRelevantWorkMomentsReq) (*FindRelevantWorkMomentsResp, error)
 	GetUnreadWorkMomentsCount(context.Context, *GetUnreadWorkMomentsCountReq) (*GetUnreadWorkMomentsCountResp, error)
 	ReadWorkMoments(context.Context, *ReadWorkMomentsReq) (*ReadWorkMomentsResp, error)
+	DeleteUserData(context.Context, *DeleteUserDataReq) (*DeleteUserDataResp, error)
+	ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataResp, error)
 }
 
 // UnimplementedOfficeServer can be embedded to have forward compatible implementations.
@@ -4716,6 +5023,12 @@ func (*UnimplementedOfficeServer) GetUnreadWorkMomentsCount(context.Context, *Ge
 func (*UnimplementedOfficeServer) ReadWorkMoments(context.Context, *ReadWorkMomentsReq) (*ReadWorkMomentsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkMoments not implemented")
 }
+func (*UnimplementedOfficeServer) DeleteUserData(context.Context, *DeleteUserDataReq) (*DeleteUserDataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (*UnimplementedOfficeServer) ExportUserData(context.Context, *ExportUserDataReq) (*ExportUserDataResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 
 func RegisterOfficeServer(s *grpc.Server, srv OfficeServer) {
 	s.RegisterService(&_Office_serviceDesc, srv)
@@ -5063,6 +5376,42 @@ func _Office_ReadWorkMoments_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Office_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfficeServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.office.Office/DeleteUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfficeServer).DeleteUserData(ctx, req.(*DeleteUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Office_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OfficeServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.office.Office/ExportUserData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OfficeServer).ExportUserData(ctx, req.(*ExportUserDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Office_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenIMChat.office.Office",
 	HandlerType: (*OfficeServer)(nil),
@@ -5143,6 +5492,14 @@ var _Office_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadWorkMoments",
 			Handler:    _Office_ReadWorkMoments_Handler,
 		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _Office_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Office_ExportUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "office/office.proto",
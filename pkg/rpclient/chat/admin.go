@@ -90,8 +90,24 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32, platform int32, deviceID string, ip string) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, Platform: platform, DeviceID: deviceID, Ip: ip})
+}
+
+func (o *AdminClient) CreateScopeToken(ctx context.Context, userID string, userType int32, platform int32, scope string) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, Platform: platform, Scope: scope})
+}
+
+// CheckPermission fails unless the admin of the context has the permission through its roles.
+func (o *AdminClient) CheckPermission(ctx context.Context, permission string) error {
+	_, err := o.client.CheckPermission(ctx, &admin.CheckPermissionReq{Permission: permission})
+	return err
+}
+
+// RevokeAllSessions revokes every session and kicks every token of the user.
+func (o *AdminClient) RevokeAllSessions(ctx context.Context, userID string) error {
+	_, err := o.client.RevokeSessions(ctx, &admin.RevokeSessionsReq{UserID: userID, Others: true})
+	return err
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
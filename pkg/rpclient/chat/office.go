@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/discoveryregistry"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/proto/office"
+)
+
+func NewOfficeClient(discov discoveryregistry.SvcDiscoveryRegistry) *OfficeClient {
+	conn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImOfficeName)
+	if err != nil {
+		panic(err)
+	}
+	return &OfficeClient{
+		client: office.NewOfficeClient(conn),
+	}
+}
+
+type OfficeClient struct {
+	client office.OfficeClient
+}
+
+func (o *OfficeClient) DeleteUserData(ctx context.Context, userIDs []string) error {
+	_, err := o.client.DeleteUserData(ctx, &office.DeleteUserDataReq{UserIDs: userIDs})
+	return err
+}
+
+func (o *OfficeClient) ExportUserData(ctx context.Context, userID string) (*office.ExportUserDataResp, error) {
+	return o.client.ExportUserData(ctx, &office.ExportUserDataReq{UserID: userID})
+}
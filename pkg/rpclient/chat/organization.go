@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/discoveryregistry"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/proto/organization"
+)
+
+func NewOrganizationClient(discov discoveryregistry.SvcDiscoveryRegistry) *OrganizationClient {
+	conn, err := discov.GetConn(context.Background(), config.Config.RpcRegisterName.OpenImOrganizationName)
+	if err != nil {
+		panic(err)
+	}
+	return &OrganizationClient{
+		client: organization.NewOrganizationClient(conn),
+	}
+}
+
+type OrganizationClient struct {
+	client organization.OrganizationClient
+}
+
+func (o *OrganizationClient) DeleteUserAllDepartment(ctx context.Context, userIDs []string) error {
+	_, err := o.client.DeleteUserAllDepartment(ctx, &organization.DeleteUserAllDepartmentReq{UserIDs: userIDs})
+	return err
+}
+
+// GetUserDepartment returns the departments the user is a member of.
+func (o *OrganizationClient) GetUserDepartment(ctx context.Context, userID string) ([]*organization.MemberDepartment, error) {
+	resp, err := o.client.GetUserInDepartment(ctx, &organization.GetUserInDepartmentReq{UserIDs: []string{userID}})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range resp.Users {
+		if user.User != nil && user.User.UserID == userID {
+			return user.Members, nil
+		}
+	}
+	return []*organization.MemberDepartment{}, nil
+}
@@ -17,6 +17,7 @@ package sms
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/OpenIMSDK/tools/errs"
 	aliconf "github.com/alibabacloud-go/darabonba-openapi/client"
@@ -26,40 +27,75 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/config"
 )
 
-func newAli() (SMS, error) {
-	conf := &aliconf.Config{
-		Endpoint:        tea.String(config.Config.VerifyCode.Ali.Endpoint),
-		AccessKeyId:     tea.String(config.Config.VerifyCode.Ali.AccessKeyId),
-		AccessKeySecret: tea.String(config.Config.VerifyCode.Ali.AccessKeySecret),
+func init() {
+	Register("ali", newAli)
+}
+
+// aliConfig converts the verifyCode.ali config used before providers could be listed.
+func aliConfig() config.SMSProvider {
+	ali := config.Config.VerifyCode.Ali
+	templates := map[string]string{
+		TemplateVerifyCode:    ali.VerificationCodeTemplateCode,
+		TemplateAccountLocked: ali.AccountLockedTemplateCode,
+		TemplateLoginAlert:    ali.LoginAlertTemplateCode,
+		TemplatePhoneChanged:  ali.PhoneChangedTemplateCode,
+	}
+	return config.SMSProvider{
+		Name:      "ali",
+		Type:      "ali",
+		Endpoint:  ali.Endpoint,
+		Key:       ali.AccessKeyId,
+		Secret:    ali.AccessKeySecret,
+		SignName:  ali.SignName,
+		Templates: templates,
 	}
-	client, err := dysmsapi.NewClient(conf)
+}
+
+func newAli(conf *config.SMSProvider) (Provider, error) {
+	client, err := dysmsapi.NewClient(&aliconf.Config{
+		Endpoint:        tea.String(conf.Endpoint),
+		AccessKeyId:     tea.String(conf.Key),
+		AccessKeySecret: tea.String(conf.Secret),
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &ali{client: client}, nil
+	return &ali{name: conf.Name, signName: conf.SignName, templates: conf.Templates, client: client}, nil
 }
 
 type ali struct {
-	client *dysmsapi.Client
+	name      string
+	signName  string
+	templates map[string]string
+	client    *dysmsapi.Client
 }
 
 func (a *ali) Name() string {
-	return "ali-sms"
+	return a.name
 }
 
-func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
-	data, err := json.Marshal(&struct {
-		Code string `json:"code"`
-	}{Code: verifyCode})
+func (a *ali) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	templateCode := a.templates[template]
+	if templateCode == "" {
+		return ErrNoTemplate
+	}
+	data, err := json.Marshal(params)
 	if err != nil {
 		return errs.Wrap(err)
 	}
 	req := &dysmsapi.SendSmsRequest{
 		PhoneNumbers:  tea.String(areaCode + phoneNumber),
-		SignName:      tea.String(config.Config.VerifyCode.Ali.SignName),
-		TemplateCode:  tea.String(config.Config.VerifyCode.Ali.VerificationCodeTemplateCode),
+		SignName:      tea.String(a.signName),
+		TemplateCode:  tea.String(templateCode),
 		TemplateParam: tea.String(string(data)),
 	}
-	_, err = a.client.SendSms(req)
-	return errs.Wrap(err)
+	resp, err := a.client.SendSms(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	// the request succeeds with a code when the message is rejected
+	if resp.Body != nil && tea.StringValue(resp.Body.Code) != "OK" {
+		return errs.Wrap(fmt.Errorf("ali sms %s: %s", tea.StringValue(resp.Body.Code), tea.StringValue(resp.Body.Message)))
+	}
+	return nil
 }
@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"sync"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func init() {
+	Register("fake", func(conf *config.SMSProvider) (Provider, error) {
+		return NewFake(conf.Name), nil
+	})
+}
+
+// Message is a message recorded by Fake.
+type Message struct {
+	AreaCode    string
+	PhoneNumber string
+	Template    string
+	Params      map[string]string
+}
+
+// Fake records and logs the messages instead of sending them, for tests and local development.
+type Fake struct {
+	name     string
+	lock     sync.Mutex
+	err      error
+	messages []Message
+}
+
+func NewFake(name string) *Fake {
+	return &Fake{name: name}
+}
+
+func (f *Fake) Name() string {
+	return f.name
+}
+
+func (f *Fake) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.messages = append(f.messages, Message{AreaCode: areaCode, PhoneNumber: phoneNumber, Template: template, Params: params})
+	log.ZInfo(ctx, "fake sms", "provider", f.name, "phone", areaCode+phoneNumber, "template", template, "params", params)
+	return nil
+}
+
+// SetError makes Send fail with err, nil sends again.
+func (f *Fake) SetError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.err = err
+}
+
+// Messages returns the messages sent so far.
+func (f *Fake) Messages() []Message {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]Message(nil), f.messages...)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+var httpClient = &http.Client{Timeout: time.Second * 10}
+
+// funcs are available in the message and body templates, json quotes a value for a json body.
+var funcs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+func parseTemplates(name string, texts map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template)
+	for typ, text := range texts {
+		if text == "" {
+			continue
+		}
+		t, err := template.New(typ).Funcs(funcs).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("sms provider %s template %s: %w", name, typ, err)
+		}
+		templates[typ] = t
+	}
+	return templates, nil
+}
+
+func render(t *template.Template, data any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return buf.String(), nil
+}
+
+// do sends the request and returns the body, a status other than 2xx is an error.
+func do(req *http.Request) ([]byte, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, errs.Wrap(fmt.Errorf("%s %s: %s %s", req.Method, req.URL.Host, resp.Status, body))
+	}
+	return body, nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+)
+
+// router picks the providers by area code and fails over in order.
+type router struct {
+	sender
+	providers map[string]Provider
+	order     []string            // providers of the area codes without a route
+	routes    map[string][]string // area code -> provider names
+}
+
+func newRouter(providers []Provider, routes map[string][]string) (*router, error) {
+	if len(providers) == 0 {
+		return nil, errors.New("no sms provider")
+	}
+	r := &router{
+		providers: make(map[string]Provider),
+		routes:    routes,
+	}
+	for _, p := range providers {
+		if _, ok := r.providers[p.Name()]; ok {
+			return nil, fmt.Errorf("duplicate sms provider %s", p.Name())
+		}
+		r.providers[p.Name()] = p
+		r.order = append(r.order, p.Name())
+	}
+	for areaCode, names := range routes {
+		for _, name := range names {
+			if _, ok := r.providers[name]; !ok {
+				return nil, fmt.Errorf("sms route %s: unknown provider %s", areaCode, name)
+			}
+		}
+	}
+	r.sender.send = r.Send
+	return r, nil
+}
+
+func (r *router) Name() string {
+	return "sms-router"
+}
+
+func (r *router) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	names, ok := r.routes[areaCode]
+	if !ok {
+		names = r.order
+	}
+	var lastErr error
+	for _, name := range names {
+		err := r.providers[name].Send(ctx, areaCode, phoneNumber, template, params)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, ErrNoTemplate) {
+			continue
+		}
+		log.ZWarn(ctx, "sms provider failed", err, "provider", name, "template", template, "areaCode", areaCode)
+		lastErr = err
+	}
+	if lastErr != nil {
+		return lastErr
+	}
+	// notices are optional, but a verify code nobody can send is a config error
+	if template == TemplateVerifyCode {
+		return errs.ErrInternalServer.Wrap("no sms provider has the template " + template)
+	}
+	return nil
+}
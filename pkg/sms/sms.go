@@ -17,25 +17,131 @@ package sms
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
 )
 
+// Template types, the params each one gets are listed in templateParams.
+const (
+	TemplateVerifyCode    = "verifyCode"
+	TemplateAccountLocked = "accountLocked"
+	TemplateLoginAlert    = "loginAlert"
+	TemplatePhoneChanged  = "phoneChanged"
+	TemplatePasswordReset = "passwordReset"
+)
+
+// templateParams is the order of the params, for providers with positional template params.
+var templateParams = map[string][]string{
+	TemplateVerifyCode:    {"code"},
+	TemplateAccountLocked: {"minutes"},
+	TemplateLoginAlert:    {"ip", "location"},
+	TemplatePhoneChanged:  {"phone"},
+	TemplatePasswordReset: {},
+}
+
+// Provider sends messages through one sms service.
+type Provider interface {
+	Name() string
+	// Send returns ErrNoTemplate if the provider has no template of the type.
+	Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error
+}
+
+// ErrNoTemplate makes the message go to the next provider, it is not a failure of the service.
+var ErrNoTemplate = fmt.Errorf("sms template not configured")
+
+// Factory builds a provider of one type from its config.
+type Factory func(conf *config.SMSProvider) (Provider, error)
+
+var (
+	lock      sync.RWMutex
+	factories = make(map[string]Factory)
+)
+
+// Register adds a provider type, the built-in ones register themselves.
+func Register(typ string, factory Factory) {
+	lock.Lock()
+	defer lock.Unlock()
+	factories[strings.ToLower(typ)] = factory
+}
+
+func newProvider(conf *config.SMSProvider) (Provider, error) {
+	lock.RLock()
+	factory, ok := factories[strings.ToLower(conf.Type)]
+	lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("not support sms: %s", conf.Type)
+	}
+	return factory(conf)
+}
+
 func New() (SMS, error) {
-	switch strings.ToLower(config.Config.VerifyCode.Use) {
-	case "":
+	use := strings.ToLower(config.Config.VerifyCode.Use)
+	if use == "" {
 		return empty{}, nil
-	case "ali":
-		return newAli()
-	default:
-		return nil, fmt.Errorf("not support sms: %s", config.Config.VerifyCode.Use)
 	}
+	confs := config.Config.VerifyCode.Providers
+	if len(confs) == 0 {
+		if use != "ali" {
+			return nil, fmt.Errorf("not support sms: %s", config.Config.VerifyCode.Use)
+		}
+		confs = []config.SMSProvider{aliConfig()}
+	}
+	providers := make([]Provider, 0, len(confs))
+	for i := range confs {
+		p, err := newProvider(&confs[i])
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, p)
+	}
+	routes := make(map[string][]string)
+	for _, route := range config.Config.VerifyCode.Routes {
+		for _, areaCode := range route.AreaCodes {
+			routes[areaCode] = route.Providers
+		}
+	}
+	return newRouter(providers, routes)
 }
 
 type SMS interface {
 	Name() string
+	// Send sends the template through the providers of the area code, trying the next one when a provider fails.
+	Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error
 	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error
+	SendAccountLocked(ctx context.Context, areaCode string, phoneNumber string, lockTime time.Duration) error
+	SendLoginAlert(ctx context.Context, areaCode string, phoneNumber string, ip string, location string) error
+	// SendPhoneChanged notifies the old phone number, newPhone is masked by the caller.
+	SendPhoneChanged(ctx context.Context, areaCode string, phoneNumber string, newPhone string) error
+	SendPasswordReset(ctx context.Context, areaCode string, phoneNumber string) error
+}
+
+// sender implements the typed methods on top of Send.
+type sender struct {
+	send func(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error
+}
+
+func (s sender) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	return s.send(ctx, areaCode, phoneNumber, TemplateVerifyCode, map[string]string{"code": verifyCode})
+}
+
+func (s sender) SendAccountLocked(ctx context.Context, areaCode string, phoneNumber string, lockTime time.Duration) error {
+	return s.send(ctx, areaCode, phoneNumber, TemplateAccountLocked, map[string]string{"minutes": strconv.Itoa(int(lockTime.Minutes()))})
+}
+
+func (s sender) SendLoginAlert(ctx context.Context, areaCode string, phoneNumber string, ip string, location string) error {
+	return s.send(ctx, areaCode, phoneNumber, TemplateLoginAlert, map[string]string{"ip": ip, "location": location})
+}
+
+func (s sender) SendPhoneChanged(ctx context.Context, areaCode string, phoneNumber string, newPhone string) error {
+	return s.send(ctx, areaCode, phoneNumber, TemplatePhoneChanged, map[string]string{"phone": newPhone})
+}
+
+func (s sender) SendPasswordReset(ctx context.Context, areaCode string, phoneNumber string) error {
+	return s.send(ctx, areaCode, phoneNumber, TemplatePasswordReset, map[string]string{})
 }
 
 type empty struct{}
@@ -44,6 +150,34 @@ func (e empty) Name() string {
 	return "empty-sms"
 }
 
+func (e empty) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	return nil
+}
+
 func (e empty) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
 	return nil
 }
+
+func (e empty) SendAccountLocked(ctx context.Context, areaCode string, phoneNumber string, lockTime time.Duration) error {
+	return nil
+}
+
+func (e empty) SendLoginAlert(ctx context.Context, areaCode string, phoneNumber string, ip string, location string) error {
+	return nil
+}
+
+func (e empty) SendPhoneChanged(ctx context.Context, areaCode string, phoneNumber string, newPhone string) error {
+	return nil
+}
+
+func (e empty) SendPasswordReset(ctx context.Context, areaCode string, phoneNumber string) error {
+	return nil
+}
+
+// e164 joins the area code and the phone number with a leading +.
+func e164(areaCode string, phoneNumber string) string {
+	if !strings.HasPrefix(areaCode, "+") {
+		areaCode = "+" + areaCode
+	}
+	return areaCode + phoneNumber
+}
@@ -0,0 +1,98 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func TestRouterFailover(t *testing.T) {
+	first, second := NewFake("first"), NewFake("second")
+	r, err := newRouter([]Provider{first, second}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.SetError(errors.New("down"))
+	if err := r.SendCode(context.Background(), "+86", "13800000000", "123456"); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Messages()) != 0 || len(second.Messages()) != 1 {
+		t.Fatalf("got %d and %d messages", len(first.Messages()), len(second.Messages()))
+	}
+	if msg := second.Messages()[0]; msg.Template != TemplateVerifyCode || msg.Params["code"] != "123456" {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	second.SetError(errors.New("down too"))
+	if err := r.SendCode(context.Background(), "+86", "13800000000", "123456"); err == nil {
+		t.Fatal("no error when every provider failed")
+	}
+}
+
+func TestRouterAreaCode(t *testing.T) {
+	local, global := NewFake("local"), NewFake("global")
+	r, err := newRouter([]Provider{global, local}, map[string][]string{"+86": {"local"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SendLoginAlert(context.Background(), "+86", "13800000000", "1.2.3.4", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.SendLoginAlert(context.Background(), "+1", "2025550100", "1.2.3.4", ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(local.Messages()) != 1 || local.Messages()[0].AreaCode != "+86" {
+		t.Fatalf("local got %+v", local.Messages())
+	}
+	if len(global.Messages()) != 1 || global.Messages()[0].AreaCode != "+1" {
+		t.Fatalf("global got %+v", global.Messages())
+	}
+	if _, err := newRouter([]Provider{local}, map[string][]string{"+1": {"missing"}}); err == nil {
+		t.Fatal("route to an unknown provider accepted")
+	}
+}
+
+func TestWebhook(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+	}))
+	defer srv.Close()
+	p, err := newWebhook(&config.SMSProvider{
+		Name:      "hook",
+		Endpoint:  srv.URL,
+		Body:      `{"to":{{json .Phone}},"content":{{json .Text}}}`,
+		Templates: map[string]string{TemplateVerifyCode: `code "{{.code}}"`},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Send(context.Background(), "+86", "13800000000", TemplateVerifyCode, map[string]string{"code": "123456"}); err != nil {
+		t.Fatal(err)
+	}
+	if body != `{"to":"+8613800000000","content":"code \"123456\""}` {
+		t.Fatalf("got body %s", body)
+	}
+	if err := p.Send(context.Background(), "+86", "13800000000", TemplatePasswordReset, nil); !errors.Is(err, ErrNoTemplate) {
+		t.Fatalf("got %v for a type without template", err)
+	}
+}
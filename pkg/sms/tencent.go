@@ -0,0 +1,174 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const (
+	tencentService = "sms"
+	tencentVersion = "2021-01-11"
+)
+
+func init() {
+	Register("tencent", newTencent)
+}
+
+// newTencent uses key and secret as the SecretId and SecretKey, appID as the SmsSdkAppId.
+// Templates are template ids, their params are passed in the order of templateParams.
+func newTencent(conf *config.SMSProvider) (Provider, error) {
+	if conf.Key == "" || conf.Secret == "" || conf.AppID == "" {
+		return nil, errors.New("tencent sms: key, secret and appID must be set")
+	}
+	endpoint := conf.Endpoint
+	if endpoint == "" {
+		endpoint = "https://sms.tencentcloudapi.com"
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("tencent sms endpoint: %w", err)
+	}
+	region := conf.Region
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+	return &tencent{
+		name:      conf.Name,
+		endpoint:  endpoint,
+		host:      u.Host,
+		region:    region,
+		appID:     conf.AppID,
+		secretID:  conf.Key,
+		secretKey: conf.Secret,
+		signName:  conf.SignName,
+		templates: conf.Templates,
+	}, nil
+}
+
+type tencent struct {
+	name      string
+	endpoint  string
+	host      string
+	region    string
+	appID     string
+	secretID  string
+	secretKey string
+	signName  string
+	templates map[string]string
+}
+
+func (t *tencent) Name() string {
+	return t.name
+}
+
+func (t *tencent) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	templateID := t.templates[template]
+	if templateID == "" {
+		return ErrNoTemplate
+	}
+	paramSet := make([]string, 0, len(templateParams[template]))
+	for _, name := range templateParams[template] {
+		paramSet = append(paramSet, params[name])
+	}
+	payload, err := json.Marshal(map[string]any{
+		"PhoneNumberSet":   []string{e164(areaCode, phoneNumber)},
+		"SmsSdkAppId":      t.appID,
+		"SignName":         t.signName,
+		"TemplateId":       templateID,
+		"TemplateParamSet": paramSet,
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", t.authorization(payload, now))
+	req.Header.Set("X-TC-Action", "SendSms")
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("X-TC-Version", tencentVersion)
+	req.Header.Set("X-TC-Region", t.region)
+	body, err := do(req)
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		Response struct {
+			Error *struct {
+				Code    string
+				Message string
+			}
+			SendStatusSet []struct {
+				Code    string
+				Message string
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return errs.Wrap(err)
+	}
+	if e := resp.Response.Error; e != nil {
+		return errs.Wrap(fmt.Errorf("tencent sms %s: %s", e.Code, e.Message))
+	}
+	for _, status := range resp.Response.SendStatusSet {
+		if status.Code != "Ok" {
+			return errs.Wrap(fmt.Errorf("tencent sms %s: %s", status.Code, status.Message))
+		}
+	}
+	return nil
+}
+
+// authorization signs the request with TC3-HMAC-SHA256.
+func (t *tencent) authorization(payload []byte, now time.Time) string {
+	date := now.UTC().Format("2006-01-02")
+	canonicalRequest := "POST\n/\n\ncontent-type:application/json; charset=utf-8\nhost:" + t.host + "\n\ncontent-type;host\n" + sha256Hex(payload)
+	scope := date + "/" + tencentService + "/tc3_request"
+	stringToSign := "TC3-HMAC-SHA256\n" + strconv.FormatInt(now.Unix(), 10) + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	key := hmacSHA256([]byte("TC3"+t.secretKey), date)
+	key = hmacSHA256(key, tencentService)
+	key = hmacSHA256(key, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	return "TC3-HMAC-SHA256 Credential=" + t.secretID + "/" + scope + ", SignedHeaders=content-type;host, Signature=" + signature
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
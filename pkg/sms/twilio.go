@@ -0,0 +1,94 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func init() {
+	Register("twilio", newTwilio)
+}
+
+// newTwilio uses key as the account sid and secret as the auth token, from is
+// the sender number or a messaging service sid. Templates are the message texts.
+func newTwilio(conf *config.SMSProvider) (Provider, error) {
+	if conf.Key == "" || conf.Secret == "" || conf.From == "" {
+		return nil, errors.New("twilio sms: key, secret and from must be set")
+	}
+	templates, err := parseTemplates(conf.Name, conf.Templates)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := conf.Endpoint
+	if endpoint == "" {
+		endpoint = "https://api.twilio.com"
+	}
+	return &twilio{
+		name:      conf.Name,
+		url:       strings.TrimSuffix(endpoint, "/") + "/2010-04-01/Accounts/" + url.PathEscape(conf.Key) + "/Messages.json",
+		sid:       conf.Key,
+		token:     conf.Secret,
+		from:      conf.From,
+		templates: templates,
+	}, nil
+}
+
+type twilio struct {
+	name      string
+	url       string
+	sid       string
+	token     string
+	from      string
+	templates map[string]*template.Template
+}
+
+func (t *twilio) Name() string {
+	return t.name
+}
+
+func (t *twilio) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	tmpl, ok := t.templates[template]
+	if !ok {
+		return ErrNoTemplate
+	}
+	text, err := render(tmpl, params)
+	if err != nil {
+		return err
+	}
+	form := url.Values{"To": {e164(areaCode, phoneNumber)}, "Body": {text}}
+	if strings.HasPrefix(t.from, "MG") {
+		form.Set("MessagingServiceSid", t.from)
+	} else {
+		form.Set("From", t.from)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.SetBasicAuth(t.sid, t.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = do(req)
+	return err
+}
@@ -0,0 +1,120 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func init() {
+	Register("webhook", newWebhook)
+}
+
+// webhookMessage is the data of the body template, and the default json body.
+type webhookMessage struct {
+	Template    string            `json:"template"`
+	AreaCode    string            `json:"areaCode"`
+	PhoneNumber string            `json:"phoneNumber"`
+	Phone       string            `json:"phone"` // e164
+	Text        string            `json:"text"`  // rendered message template, empty without templates
+	Params      map[string]string `json:"params"`
+}
+
+// newWebhook posts every message to endpoint. Templates are message texts, without
+// templates every type is posted with an empty text. Body is a template over
+// webhookMessage, for example {"to":{{json .Phone}},"content":{{json .Text}}}.
+func newWebhook(conf *config.SMSProvider) (Provider, error) {
+	if conf.Endpoint == "" {
+		return nil, errors.New("webhook sms: endpoint must be set")
+	}
+	templates, err := parseTemplates(conf.Name, conf.Templates)
+	if err != nil {
+		return nil, err
+	}
+	w := &webhook{name: conf.Name, url: conf.Endpoint, headers: conf.Headers, templates: templates}
+	if conf.Body != "" {
+		w.body, err = template.New("body").Funcs(funcs).Parse(conf.Body)
+		if err != nil {
+			return nil, errs.Wrap(err, "webhook sms body")
+		}
+	}
+	return w, nil
+}
+
+type webhook struct {
+	name      string
+	url       string
+	headers   map[string]string
+	body      *template.Template
+	templates map[string]*template.Template
+}
+
+func (w *webhook) Name() string {
+	return w.name
+}
+
+func (w *webhook) Send(ctx context.Context, areaCode string, phoneNumber string, template string, params map[string]string) error {
+	msg := &webhookMessage{
+		Template:    template,
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		Phone:       e164(areaCode, phoneNumber),
+		Params:      params,
+	}
+	if len(w.templates) > 0 {
+		tmpl, ok := w.templates[template]
+		if !ok {
+			return ErrNoTemplate
+		}
+		text, err := render(tmpl, params)
+		if err != nil {
+			return err
+		}
+		msg.Text = text
+	}
+	var body string
+	if w.body == nil {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		body = string(data)
+	} else {
+		var err error
+		body, err = render(w.body, msg)
+		if err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, strings.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	_, err = do(req)
+	return err
+}